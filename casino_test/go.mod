@@ -7,3 +7,5 @@ require gopkg.in/yaml.v3 v3.0.1
 require github.com/go-chi/chi/v5 v5.2.3
 
 require github.com/go-chi/cors v1.2.2
+
+require github.com/gorilla/websocket v1.5.3
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"casino_test/internal/converter"
+	"casino_test/internal/service"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+type CrashFeedHandlerDependencies struct {
+	Serv           service.CrashService
+	AllowedOrigins []string
+}
+
+// CrashFeedHandler лента краш-игры по WebSocket. Остальные маршруты игры обслуживает общий обработчик реестра
+type CrashFeedHandler struct {
+	serv     service.CrashService
+	upgrader websocket.Upgrader
+}
+
+func NewCrashFeedHandler(deps CrashFeedHandlerDependencies) *CrashFeedHandler {
+	return &CrashFeedHandler{
+		serv: deps.Serv,
+		// CORS на апгрейд WebSocket не действует — источник проверяем сами по тому же списку
+		upgrader: websocket.Upgrader{CheckOrigin: originChecker(deps.AllowedOrigins)},
+	}
+}
+
+// Feed отдаёт ленту раундов по WebSocket: смена фаз, множитель, ставки и кэшауты
+func (h *CrashFeedHandler) Feed(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade уже ответил клиенту ошибкой
+		return
+	}
+	defer conn.Close()
+
+	events, unsubscribe := h.serv.Subscribe()
+	defer unsubscribe()
+
+	// Входящие сообщения не нужны — читаем только чтобы заметить закрытие соединения
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if err := conn.WriteJSON(converter.ToCrashEvent(event)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package dto
+
+type BlackjackDealRequest struct {
+	Bet int `json:"bet"` // Ставка
+}
+
+type BlackjackInsuranceRequest struct {
+	Take bool `json:"take"` // Брать ли страховку (половина ставки)
+}
+
+type BlackjackHand struct {
+	Cards   []Card `json:"cards"`
+	Bet     int    `json:"bet"`     // Ставка на руке (после дабла — удвоенная)
+	Doubled bool   `json:"doubled"` // Был ли дабл
+	Split   bool   `json:"split"`   // Рука получена сплитом
+	Done    bool   `json:"done"`    // Ход по руке закончен
+	Value   int    `json:"value"`   // Очки
+	Soft    bool   `json:"soft"`    // Мягкая рука (туз за 11)
+	Result  string `json:"result"`  // blackjack/win/push/lose/bust/surrender ("" — раунд идёт)
+	Payout  int    `json:"payout"`  // Выплата вместе со ставкой
+}
+
+type BlackjackRound struct {
+	Phase           string          `json:"phase"` // insurance/player/finished
+	Bet             int             `json:"bet"`   // Исходная ставка
+	Hands           []BlackjackHand `json:"hands"`
+	Current         int             `json:"current"`          // Рука, по которой сейчас ход
+	Dealer          []Card          `json:"dealer"`           // Карты дилера (пока раунд идёт — только открытая)
+	Insurance       int             `json:"insurance"`        // Ставка страховки
+	InsurancePayout int             `json:"insurance_payout"` // Выплата по страховке
+	TotalPayout     int             `json:"total_payout"`     // Всего зачислено за раунд
+}
+
+type BlackjackTableResponse struct {
+	Round       *BlackjackRound `json:"round"`        // Текущий или последний раунд (null — ещё не играли)
+	DealerValue int             `json:"dealer_value"` // Очки по видимым картам дилера
+	Actions     []string        `json:"actions"`      // Доступные действия
+	ShoeLeft    int             `json:"shoe_left"`    // Карт осталось в шузе
+	Balance     int             `json:"balance"`      // Баланс пользователя
+}
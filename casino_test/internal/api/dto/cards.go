@@ -0,0 +1,6 @@
+package dto
+
+type Card struct {
+	Rank string `json:"rank"` // 2-10, J, Q, K, A
+	Suit string `json:"suit"` // hearts/diamonds/clubs/spades
+}
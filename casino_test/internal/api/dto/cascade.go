@@ -14,6 +14,9 @@ type CascadeSpinResponse struct {
 	AwardedFreeSpins int           `json:"awarded_free_spins"` // Начислено фриспинов в этом спине
 	FreeSpinsLeft    int           `json:"free_spins_left"`    // Остаток фриспинов после спина
 	InFreeSpin       bool          `json:"in_free_spin"`       // Это был фриспин?
+	MaxWinReached    bool          `json:"max_win_reached"`    // Достигнут лимит выигрыша — каскады и бонус остановлены
+	Wheel            *Wheel        `json:"wheel"`              // Запущенное колесо фортуны (null — не запущено)
+	Jackpots         []JackpotWin  `json:"jackpots"`           // Выигранные джекпоты (уже в balance)
 }
 
 type CascadeStep struct {
@@ -42,20 +45,36 @@ type NewSymbol struct {
 
 // Bonus Buy
 type BuyCascadeBonusRequest struct {
-	Amount int `json:"amount"` // Сумма покупки (обычно = bet × 100)
+	ProductID string `json:"product_id"` // ID продукта из /cascade/game-info
+	Bet       int    `json:"bet"`        // Ставка, от которой считается цена (цена = bet × price_x_bet)
 }
 
 type BuyBonusResponse struct {
 	Success       bool   `json:"success"`
 	Message       string `json:"message,omitempty"`
+	ProductID     string `json:"product_id,omitempty"`
 	AwardedSpins  int    `json:"awarded_spins,omitempty"`
 	Cost          int    `json:"cost,omitempty"`
 	Balance       int    `json:"balance,omitempty"`
 	FreeSpinsLeft int    `json:"free_spins_left,omitempty"`
 }
 
+type BonusBuyProduct struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	PriceXBet       int    `json:"price_x_bet"`      // Цена в кратности ставки
+	FreeSpins       int    `json:"free_spins"`       // Количество фриспинов
+	StartMultiplier int    `json:"start_multiplier"` // Стартовый множитель на всех ячейках
+}
+
+type CascadeGameInfoResponse struct {
+	BonusBuys  []BonusBuyProduct `json:"bonus_buys"`    // Доступные покупки бонуса
+	MaxWinXBet int               `json:"max_win_x_bet"` // Лимит выигрыша в кратности ставки
+}
+
 // Общий ответ на запрос данных (баланс + фриспины)
 type CascadeDataResponse struct {
-	Balance       int `json:"balance"`
-	FreeSpinsLeft int `json:"free_spins_left"`
+	Balance       int    `json:"balance"`
+	FreeSpinsLeft int    `json:"free_spins_left"`
+	Wheel         *Wheel `json:"wheel"` // Незавершённое колесо фортуны (null — нет)
 }
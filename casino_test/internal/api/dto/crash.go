@@ -0,0 +1,50 @@
+package dto
+
+type CrashBetRequest struct {
+	PlayerID    string  `json:"player_id"`    // Имя игрока за столом
+	Amount      int     `json:"amount"`       // Сумма ставки
+	AutoCashout float64 `json:"auto_cashout"` // Автокэшаут (0 — забирать вручную)
+}
+
+type CrashCashoutRequest struct {
+	Token string `json:"token"` // Секрет из ответа на ставку
+}
+
+type CrashRound struct {
+	ID          int     `json:"id"`                    // Номер раунда
+	Phase       string  `json:"phase"`                 // betting/running/crashed
+	Hash        string  `json:"hash"`                  // sha256 от seed, публикуется до раунда
+	Seed        string  `json:"seed,omitempty"`        // Раскрывается после краша
+	CrashPoint  float64 `json:"crash_point,omitempty"` // Раскрывается после краша
+	Multiplier  float64 `json:"multiplier"`            // Текущий множитель
+	BetsUntilMs int64   `json:"bets_until_ms"`         // До какого момента принимаются ставки (unix ms)
+	StartedAtMs int64   `json:"started_at_ms"`         // Когда пошёл множитель (unix ms, 0 — ещё не пошёл)
+}
+
+type CrashBet struct {
+	PlayerID    string  `json:"player_id"`
+	Amount      int     `json:"amount"`
+	AutoCashout float64 `json:"auto_cashout"`
+	CashedOut   bool    `json:"cashed_out"`
+	CashoutAt   float64 `json:"cashout_at"` // На каком множителе забрал
+	Payout      int     `json:"payout"`     // Выплата
+}
+
+// CrashPlacedBet ответ на ставку: только здесь клиент получает секрет для кэшаута
+type CrashPlacedBet struct {
+	CrashBet
+	Token string `json:"token"`
+}
+
+// CrashEvent сообщение ленты WebSocket
+type CrashEvent struct {
+	Type  string     `json:"type"` // round/tick/bet/cashout/crash
+	Round CrashRound `json:"round"`
+	Bet   *CrashBet  `json:"bet,omitempty"` // Для событий bet и cashout
+}
+
+type CrashStateResponse struct {
+	Round   CrashRound   `json:"round"`
+	Bets    []CrashBet   `json:"bets"`    // Ставки текущего раунда
+	History []CrashRound `json:"history"` // Последние раунды (с раскрытыми seed)
+}
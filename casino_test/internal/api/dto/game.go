@@ -0,0 +1,14 @@
+package dto
+
+type GameMeta struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Category    string `json:"category"` // slot/table/cards/instant/lottery
+	Description string `json:"description"`
+	Path        string `json:"path"`                  // Префикс маршрутов игры: /games/{id}
+	LegacyPath  string `json:"legacy_path,omitempty"` // Старый префикс, который тоже работает
+}
+
+type GamesResponse struct {
+	Games []GameMeta `json:"games"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type JackpotWin struct {
+	ID     string `json:"id"`     // mini/minor/major/grand
+	Amount int    `json:"amount"` // Выплата
+}
+
+type JackpotPool struct {
+	ID    string `json:"id"`    // Уровень джекпота
+	Value int    `json:"value"` // Текущая сумма пула
+}
+
+type JackpotsResponse struct {
+	Pools []JackpotPool `json:"pools"`
+}
@@ -0,0 +1,45 @@
+package dto
+
+type KenoTicketRequest struct {
+	Picks []int `json:"picks"` // Отмеченные числа 1..80 (от 1 до 10 штук)
+	Bet   int   `json:"bet"`   // Ставка на один тираж
+	Draws int   `json:"draws"` // Сколько тиражей подряд (0 — один)
+}
+
+type KenoDraw struct {
+	DrawID     int     `json:"draw_id"`    // Номер тиража
+	Numbers    []int   `json:"numbers"`    // 20 выпавших чисел
+	Hits       []int   `json:"hits"`       // Совпавшие отметки
+	Multiplier float64 `json:"multiplier"` // Множитель по таблице
+	Payout     int     `json:"payout"`     // Выигрыш в тираже
+}
+
+type KenoTicket struct {
+	ID          int        `json:"id"`
+	Picks       []int      `json:"picks"`
+	Bet         int        `json:"bet"`          // Ставка на тираж
+	Draws       int        `json:"draws"`        // Тиражей в билете
+	TotalBet    int        `json:"total_bet"`    // Стоимость билета
+	TotalPayout int        `json:"total_payout"` // Выигрыш по всем тиражам
+	Results     []KenoDraw `json:"results"`      // Результат каждого тиража
+}
+
+type KenoTicketResponse struct {
+	Ticket  KenoTicket `json:"ticket"`
+	Balance int        `json:"balance"` // Баланс после
+}
+
+type KenoTicketsResponse struct {
+	Tickets []KenoTicket `json:"tickets"` // Последние билеты (новые в начале)
+}
+
+type KenoGameInfoResponse struct {
+	Numbers  int                     `json:"numbers"`   // Чисел на поле
+	Drawn    int                     `json:"drawn"`     // Шаров в тираже
+	MinPicks int                     `json:"min_picks"` // Минимум отметок
+	MaxPicks int                     `json:"max_picks"` // Максимум отметок
+	MaxDraws int                     `json:"max_draws"` // Максимум тиражей в билете
+	MinBet   int                     `json:"min_bet"`
+	MaxBet   int                     `json:"max_bet"`
+	PayTable map[int]map[int]float64 `json:"paytable"` // Отметок -> совпадений -> множитель
+}
@@ -1,37 +1,151 @@
 package dto
 
 type LineSpinRequest struct {
-	Bet int `json:"bet"` // Размер ставки (положительное целое, >0)
+	Bet   int `json:"bet"`   // Размер ставки на все линии (положительное целое, >0)
+	Lines int `json:"lines"` // Количество линий 1..N (0 или не указано — все линии)
 }
 
 type LineSpinResponse struct {
-	Board            [5][3]string `json:"board"`              // Символы (ID)
-	LineWins         []LineWin    `json:"line_wins"`          // Выигрышные линии
-	ScatterCount     int          `json:"scatter_count"`      // Кол-во скаттеров
-	ScatterPayout    int          `json:"scatter_payout"`     // Выплата по скаттерам
-	AwardedFreeSpins int          `json:"awarded_free_spins"` // Начислено фриспинов в этом спине
-	TotalPayout      int          `json:"total_payout"`       // Общая выплата
-	Balance          int          `json:"balance"`            // Баланс после
-	FreeSpinCount    int          `json:"free_spin_count"`    // Остаток фриспинов
-	InFreeSpin       bool         `json:"in_free_spin"`       // Это фриспин?
+	InitialBoard     [5][3]string   `json:"initial_board"`      // Поле до каскадов
+	Board            [5][3]string   `json:"board"`              // Символы (ID), итоговое поле
+	Tumbles          []TumbleStep   `json:"tumbles"`            // Шаги каскадов (для анимации)
+	WildReels        []int          `json:"wild_reels"`         // Барабаны (0-4), целиком ставшие вайлдами
+	WildMultipliers  []int          `json:"wild_multipliers"`   // Множители вайлд-барабанов (в порядке wild_reels)
+	SpinMultiplier   int            `json:"spin_multiplier"`    // Общий множитель фриспина
+	LineWins         []LineWin      `json:"line_wins"`          // Выигрышные линии
+	ScatterCount     int            `json:"scatter_count"`      // Кол-во скаттеров
+	ScatterPositions []LinePosition `json:"scatter_positions"`  // Позиции сыгравших скаттеров
+	ScatterPayout    int            `json:"scatter_payout"`     // Выплата по скаттерам
+	AwardedFreeSpins int            `json:"awarded_free_spins"` // Начислено фриспинов в этом спине
+	TotalPayout      int            `json:"total_payout"`       // Общая выплата
+	Balance          int            `json:"balance"`            // Баланс после
+	FreeSpinCount    int            `json:"free_spin_count"`    // Остаток фриспинов
+	InFreeSpin       bool           `json:"in_free_spin"`       // Это фриспин?
+	MaxWinReached    bool           `json:"max_win_reached"`    // Достигнут лимит выигрыша — бонус остановлен
+	Lines            int            `json:"lines"`              // Сыгранных линий (ставка на линию = bet / lines)
+	MoneyCells       []MoneyCell    `json:"money_cells"`        // Денежные символы на поле
+	HoldAndWin       *HoldAndWin    `json:"hold_and_win"`       // Запущенный hold and win (null — не запущен)
+	Gamble           *Gamble        `json:"gamble"`             // Выигрыш ждёт удвоения или сбора (null — зачислен)
+	PickEm           *PickEm        `json:"pick_em"`            // Запущенный pick-em (null — не запущен)
+	Wheel            *Wheel         `json:"wheel"`              // Запущенное колесо фортуны (null — не запущено)
+	Jackpots         []JackpotWin   `json:"jackpots"`           // Выигранные джекпоты (уже в balance)
 }
 
 type BuyBonusRequest struct {
 	Amount int `json:"amount"` // Сумма покупки бонуса
 }
 
-type DepositRequest struct {
-	Amount int `json:"amount"` // Сумма депозита
-}
-
 type DataResponse struct {
-	Balance       int `json:"balance"`         // Баланс пользователя
-	FreeSpinCount int `json:"free_spin_count"` // Остаток фриспинов
+	Balance       int         `json:"balance"`         // Баланс пользователя
+	FreeSpinCount int         `json:"free_spin_count"` // Остаток фриспинов
+	HoldAndWin    *HoldAndWin `json:"hold_and_win"`    // Незавершённый hold and win (null — нет)
+	Gamble        *Gamble     `json:"gamble"`          // Несобранный выигрыш (null — нет)
+	PickEm        *PickEm     `json:"pick_em"`         // Незавершённый pick-em (null — нет)
+	Wheel         *Wheel      `json:"wheel"`           // Незавершённое колесо фортуны (null — нет)
 }
 
 type LineWin struct {
-	Line   int    `json:"line"`   // 1-20
-	Symbol string `json:"symbol"` // ID символа
-	Count  int    `json:"count"`  // 3-5
-	Payout int    `json:"payout"` // Выплата
+	Line       int            `json:"line"`                // Номер линии (с 1), 0 в режиме ways
+	Symbol     string         `json:"symbol"`              // ID символа
+	Count      int            `json:"count"`               // 3-5
+	Payout     int            `json:"payout"`              // Выплата
+	Ways       int            `json:"ways,omitempty"`      // Количество путей (режим ways)
+	Positions  []LinePosition `json:"positions,omitempty"` // Ячейки выигрыша
+	Direction  string         `json:"direction"`           // ltr — слева направо, rtl — справа налево
+	Multiplier int            `json:"multiplier"`          // Множитель выигрыша (вайлды × фриспин)
+}
+
+type LinePosition struct {
+	Reel int `json:"reel"` // Барабан 0-4
+	Row  int `json:"row"`  // Строка 0-2 (0 — верхняя)
+}
+
+type TumbleStep struct {
+	Index      int            `json:"index"`       // 0 = начальное поле
+	Board      [5][3]string   `json:"board"`       // Поле, на котором посчитаны выигрыши шага
+	Wins       []LineWin      `json:"wins"`        // Выигрыши шага
+	Multiplier int            `json:"multiplier"`  // Множитель шага
+	Removed    []LinePosition `json:"removed"`     // Удалённые ячейки
+	NewSymbols []LineSymbol   `json:"new_symbols"` // Новые символы, упавшие сверху
+}
+
+type LineSymbol struct {
+	Position LinePosition `json:"position"`
+	Symbol   string       `json:"symbol"`
+}
+
+type MoneyCell struct {
+	Position LinePosition `json:"position"`
+	Value    int          `json:"value"`             // Значение символа
+	Jackpot  string       `json:"jackpot,omitempty"` // mini/minor/major
+}
+
+type HoldAndWin struct {
+	Bet         int         `json:"bet"`          // Ставка, запустившая бонус
+	RespinsLeft int         `json:"respins_left"` // Оставшиеся респины
+	Cells       []MoneyCell `json:"cells"`        // Залипшие денежные символы
+}
+
+type HoldAndWinRespinResponse struct {
+	HoldAndWin  HoldAndWin  `json:"hold_and_win"` // Состояние после респина
+	NewCells    []MoneyCell `json:"new_cells"`    // Выпавшие в этом респине символы
+	Finished    bool        `json:"finished"`     // Бонус закончился
+	Grand       bool        `json:"grand"`        // Поле заполнено — выдан grand
+	TotalPayout int         `json:"total_payout"` // Выплата за бонус (при завершении)
+	Balance     int         `json:"balance"`      // Баланс после
+}
+
+type Gamble struct {
+	Win       int  `json:"win"`        // Несобранный выигрыш
+	Step      int  `json:"step"`       // Сколько раз уже рискнули
+	CanGamble bool `json:"can_gamble"` // Можно ли рискнуть ещё раз
+}
+
+type GambleRequest struct {
+	Choice string `json:"choice"` // red/black (x2) или hearts/diamonds/clubs/spades (x4)
+}
+
+type GambleCard struct {
+	Rank string `json:"rank"`
+	Suit string `json:"suit"`
+}
+
+type GambleResponse struct {
+	Choice  string     `json:"choice"`  // Что выбрал игрок
+	Card    GambleCard `json:"card"`    // Открытая карта
+	Won     bool       `json:"won"`     // Угадал ли
+	Gamble  *Gamble    `json:"gamble"`  // Состояние после попытки (null — выигрыш сгорел)
+	Balance int        `json:"balance"` // Баланс
+}
+
+type CollectResponse struct {
+	Amount  int `json:"amount"`  // Зачисленный выигрыш
+	Balance int `json:"balance"` // Баланс после
+}
+
+type PickEm struct {
+	Cells      int          `json:"cells"`      // Сколько ячеек на выбор
+	Picks      []PickEmPick `json:"picks"`      // Открытые ячейки
+	PicksLeft  int          `json:"picks_left"` // Оставшиеся выборы
+	Credits    int          `json:"credits"`    // Собрано (в деньгах, без множителя)
+	Multiplier int          `json:"multiplier"` // Общий множитель
+}
+
+type PickEmPick struct {
+	Index int    `json:"index"` // Номер ячейки (с 0)
+	Type  string `json:"type"`  // credit/multiplier/extra_pick/collect
+	Value int    `json:"value"` // Значение приза (credit — в деньгах)
+}
+
+type PickEmPickRequest struct {
+	Index int `json:"index"` // Номер ячейки (с 0)
+}
+
+type PickEmPickResponse struct {
+	Pick        PickEmPick   `json:"pick"`         // Что открылось
+	PickEm      PickEm       `json:"pick_em"`      // Состояние после выбора
+	Finished    bool         `json:"finished"`     // Бонус закончился
+	Unpicked    []PickEmPick `json:"unpicked"`     // Содержимое остальных ячеек (при завершении)
+	TotalPayout int          `json:"total_payout"` // Выплата за бонус (при завершении)
+	Balance     int          `json:"balance"`      // Баланс после
 }
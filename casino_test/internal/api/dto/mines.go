@@ -0,0 +1,36 @@
+package dto
+
+type MinesStartRequest struct {
+	PlayerID string `json:"player_id"` // Имя игрока
+	Bet      int    `json:"bet"`       // Ставка
+	Mines    int    `json:"mines"`     // Число мин на поле 5x5
+}
+
+type MinesRevealRequest struct {
+	PlayerID string `json:"player_id"`
+	Tile     int    `json:"tile"` // Клетка 0..24 (строка * 5 + колонка)
+}
+
+type MinesCashoutRequest struct {
+	PlayerID string `json:"player_id"`
+}
+
+type MinesRoundResponse struct {
+	PlayerID       string  `json:"player_id"`
+	Status         string  `json:"status"` // active/busted/cashed_out
+	Bet            int     `json:"bet"`
+	Mines          int     `json:"mines"`
+	Revealed       []int   `json:"revealed"`         // Открытые безопасные клетки
+	Multiplier     float64 `json:"multiplier"`       // Текущий множитель
+	NextMultiplier float64 `json:"next_multiplier"`  // Множитель после следующей клетки
+	Payout         int     `json:"payout"`           // Выплата (после кэшаута)
+	HitMine        *int    `json:"hit_mine"`         // Клетка с миной, на которой закончился раунд
+	Hash           string  `json:"hash"`             // sha256("salt:мины"), известен с начала раунда
+	Salt           string  `json:"salt,omitempty"`   // Раскрывается после окончания раунда
+	Layout         []int   `json:"layout,omitempty"` // Клетки с минами, раскрываются после окончания раунда
+}
+
+type MinesStateResponse struct {
+	Round   *MinesRoundResponse `json:"round"`   // Последний раунд игрока (null — ещё не играл)
+	Balance int                 `json:"balance"` // Баланс после
+}
@@ -0,0 +1,33 @@
+package dto
+
+type PlinkoDropRequest struct {
+	Bet  int    `json:"bet"`  // Ставка
+	Rows int    `json:"rows"` // Число рядов колышков 8-16
+	Risk string `json:"risk"` // low/medium/high
+}
+
+type PlinkoDropResponse struct {
+	Bet        int      `json:"bet"`
+	Rows       int      `json:"rows"`
+	Risk       string   `json:"risk"`
+	Path       []string `json:"path"`       // Отскок на каждом ряду: L или R
+	Bucket     int      `json:"bucket"`     // Лунка слева направо (0..rows)
+	Multiplier float64  `json:"multiplier"` // Множитель лунки
+	Payout     int      `json:"payout"`     // Выигрыш
+	Balance    int      `json:"balance"`    // Баланс после
+}
+
+type PlinkoTable struct {
+	Risk        string    `json:"risk"`
+	Rows        int       `json:"rows"`
+	Multipliers []float64 `json:"multipliers"` // Множители лунок слева направо
+}
+
+type PlinkoGameInfoResponse struct {
+	MinRows   int           `json:"min_rows"`
+	MaxRows   int           `json:"max_rows"`
+	MinBet    int           `json:"min_bet"`
+	MaxBet    int           `json:"max_bet"`
+	BetLadder []int         `json:"bet_ladder"` // Допустимые ставки (пусто — любая в пределах лимитов)
+	Tables    []PlinkoTable `json:"tables"`
+}
@@ -0,0 +1,33 @@
+package dto
+
+type RouletteBet struct {
+	Type    string `json:"type"`    // straight/split/street/corner/six_line/dozen/column/red/black/odd/even/low/high
+	Numbers []int  `json:"numbers"` // Числа ставки; для dozen и column — номер 1-3; для равных шансов — пусто
+	Amount  int    `json:"amount"`  // Сумма ставки
+}
+
+type RouletteSpinRequest struct {
+	Bets []RouletteBet `json:"bets"`
+}
+
+type RouletteBetResult struct {
+	Type    string `json:"type"`
+	Numbers []int  `json:"numbers"`
+	Amount  int    `json:"amount"`
+	Won     bool   `json:"won"`    // Сыграла ли ставка
+	Payout  int    `json:"payout"` // Выплата вместе со ставкой
+}
+
+type RouletteSpinResponse struct {
+	Number      int                 `json:"number"`       // Выпавшее число 0-36
+	Color       string              `json:"color"`        // red/black/green
+	Bets        []RouletteBetResult `json:"bets"`         // Итог по каждой ставке
+	TotalBet    int                 `json:"total_bet"`    // Сумма ставок
+	TotalPayout int                 `json:"total_payout"` // Сумма выплат
+	Balance     int                 `json:"balance"`      // Баланс после
+}
+
+type RouletteDataResponse struct {
+	Balance int   `json:"balance"` // Баланс пользователя
+	History []int `json:"history"` // Последние выпавшие числа (новые в начале)
+}
@@ -0,0 +1,31 @@
+package dto
+
+type VideoPokerDealRequest struct {
+	Bet int `json:"bet"` // Ставка
+}
+
+type VideoPokerDrawRequest struct {
+	Held []bool `json:"held"` // Какие из пяти карт оставить
+}
+
+type VideoPokerDealResponse struct {
+	Bet     int    `json:"bet"`
+	Hand    []Card `json:"hand"`    // Пять карт на руках
+	Combo   string `json:"combo"`   // Комбинация после раздачи ("" — нет)
+	Balance int    `json:"balance"` // Баланс после списания ставки
+}
+
+type VideoPokerDrawResponse struct {
+	Bet     int    `json:"bet"`
+	Hand    []Card `json:"hand"`    // Итоговая рука
+	Held    []bool `json:"held"`    // Удержанные карты
+	Combo   string `json:"combo"`   // Итоговая комбинация ("" — без выигрыша)
+	Payout  int    `json:"payout"`  // Выигрыш
+	Balance int    `json:"balance"` // Баланс после
+}
+
+type VideoPokerDataResponse struct {
+	Balance  int                     `json:"balance"`  // Баланс пользователя
+	Hand     *VideoPokerDealResponse `json:"hand"`     // Раздача, ждущая замены (null — нет)
+	PayTable map[string]int          `json:"paytable"` // Выплаты за комбинации в кратности ставки
+}
@@ -0,0 +1,9 @@
+package dto
+
+type DepositRequest struct {
+	Amount int `json:"amount"` // Сумма депозита
+}
+
+type WalletDataResponse struct {
+	Balance int `json:"balance"`
+}
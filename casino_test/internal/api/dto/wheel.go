@@ -0,0 +1,26 @@
+package dto
+
+type WheelSegment struct {
+	Type  string `json:"type"`  // credit/multiplier/free_spins/level_up
+	Value int    `json:"value"` // Кратность ставки, множитель или число фриспинов
+}
+
+type Wheel struct {
+	WheelID    string         `json:"wheel_id"`   // Текущее колесо
+	Level      int            `json:"level"`      // 0 — внешнее колесо
+	Multiplier int            `json:"multiplier"` // Накопленный множитель
+	Segments   []WheelSegment `json:"segments"`   // Сектора по часовой стрелке
+}
+
+type WheelSpinResponse struct {
+	WheelID       string       `json:"wheel_id"`        // На каком колесе крутили
+	SegmentIndex  int          `json:"segment_index"`   // Выпавший сектор
+	Segment       WheelSegment `json:"segment"`         // Что в нём
+	Angle         float64      `json:"angle"`           // Угол остановки (градусы по часовой от начала сектора 0)
+	Finished      bool         `json:"finished"`        // Колесо больше не крутится
+	Payout        int          `json:"payout"`          // Выигрыш
+	FreeSpins     int          `json:"free_spins"`      // Выигранные фриспины
+	Next          *Wheel       `json:"next"`            // Колесо для следующего вращения (null — бонус окончен)
+	Balance       int          `json:"balance"`         // Баланс после
+	FreeSpinCount int          `json:"free_spin_count"` // Фриспинов на счету
+}
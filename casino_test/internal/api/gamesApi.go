@@ -0,0 +1,26 @@
+package api
+
+import (
+	"casino_test/internal/converter"
+	"casino_test/internal/service"
+	"casino_test/pkg/resp"
+	"net/http"
+)
+
+type GamesHandlerDependencies struct {
+	Catalog service.GameCatalog
+}
+
+type GamesHandler struct {
+	catalog service.GameCatalog
+}
+
+func NewGamesHandler(deps GamesHandlerDependencies) *GamesHandler {
+	return &GamesHandler{catalog: deps.Catalog}
+}
+
+// List отдаёт подключённые игры и их адреса
+func (h *GamesHandler) List(w http.ResponseWriter, r *http.Request) {
+	response := converter.ToGamesResponse(h.catalog.List())
+	resp.WriteJSONResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,30 @@
+package api
+
+import (
+	"casino_test/internal/converter"
+	"casino_test/internal/service"
+	"casino_test/pkg/resp"
+	"net/http"
+)
+
+type JackpotHandlerDependencies struct {
+	Serv service.JackpotService
+}
+
+type JackpotHandler struct {
+	serv service.JackpotService
+}
+
+func NewJackpotHandler(deps JackpotHandlerDependencies) *JackpotHandler {
+	return &JackpotHandler{serv: deps.Serv}
+}
+
+func (h *JackpotHandler) Pools(w http.ResponseWriter, r *http.Request) {
+	pools, err := h.serv.Pools(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response := converter.ToJackpotsResponse(pools)
+	resp.WriteJSONResponse(w, http.StatusOK, response)
+}
@@ -0,0 +1,23 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// originChecker проверка заголовка Origin по списку разрешённых источников (точное совпадение без учёта регистра).
+// Запросы без Origin приходят не из браузера — их пропускаем
+func originChecker(allowed []string) func(r *http.Request) bool {
+	normalized := make([]string, len(allowed))
+	for i, origin := range allowed {
+		normalized[i] = strings.ToLower(origin)
+	}
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		return slices.Contains(normalized, strings.ToLower(origin))
+	}
+}
@@ -0,0 +1,49 @@
+package api
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/converter"
+	"casino_test/internal/service"
+	"casino_test/pkg/req"
+	"casino_test/pkg/resp"
+	"net/http"
+)
+
+type WalletHandlerDependencies struct {
+	Serv service.WalletService
+}
+
+// WalletHandler депозит и баланс общего кошелька — обслуживает все игры
+type WalletHandler struct {
+	serv service.WalletService
+}
+
+func NewWalletHandler(deps WalletHandlerDependencies) *WalletHandler {
+	return &WalletHandler{serv: deps.Serv}
+}
+
+func (h *WalletHandler) Deposit(w http.ResponseWriter, r *http.Request) {
+	payload, err := req.Decode[dto.DepositRequest](r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if _, err := h.serv.Deposit(r.Context(), payload.Amount); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp.WriteJSONResponse(w, http.StatusOK, map[string]string{"result": "ok"})
+}
+
+func (h *WalletHandler) CheckData(w http.ResponseWriter, r *http.Request) {
+	data, err := h.serv.CheckData(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := converter.ToWalletDataResponse(*data)
+	resp.WriteJSONResponse(w, http.StatusOK, response)
+}
@@ -1,6 +1,9 @@
 package app
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 type App struct {
 	ServiceProvider *ServiceProvider
@@ -12,8 +15,8 @@ func NewApp() *App {
 
 func (s *App) initServiceProvider() {
 	s.ServiceProvider = newServiceProvider()
-	// Регистрируем обработчики и маршруты на роутере
-	_ = s.ServiceProvider.Handler()
+	// Собираем игры из реестра
+	_ = s.ServiceProvider.Registry()
 }
 
 func (s *App) Run() error {
@@ -21,6 +24,9 @@ func (s *App) Run() error {
 
 	r := s.ServiceProvider.Router()
 
+	// Фоновая работа игр (раунды краш-игры идут независимо от запросов)
+	s.ServiceProvider.Registry().Start(context.Background())
+
 	err := http.ListenAndServe(":8080", r)
 	if err != nil {
 		return err
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/blackjackRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/blackjack"
+	"context"
+	"net/http"
+)
+
+func blackjackGame() registry.Game {
+	// Все действия за столом отвечают одинаково — состоянием стола
+	table := registry.Reply(converter.ToBlackjackTableResponse)
+	action := func(path string, call func(serv service.BlackjackService, ctx context.Context) (*model.BlackjackTable, error)) registry.Endpoint[service.BlackjackService] {
+		return registry.Action(path, func(r *http.Request, serv service.BlackjackService) (dto.BlackjackTableResponse, error) {
+			return table(call(serv, r.Context()))
+		})
+	}
+
+	return &registry.Definition[config.BlackjackConfig, repository.BlackjackRepository, service.BlackjackService]{
+		ID:          "blackjack",
+		Name:        "Blackjack",
+		Category:    model.GameCategoryCards,
+		Description: "Блэкджек на одного игрока: сплит, дабл, страховка и сдача",
+		LegacyPath:  "/blackjack",
+		LoadConfig:  env.NewBlackjackConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.BlackjackRepository {
+			return blackjackRepo.NewBlackjackRepository()
+		},
+		NewService: func(cfg config.BlackjackConfig, repo repository.BlackjackRepository, deps registry.Deps) service.BlackjackService {
+			return blackjack.NewBlackjackService(cfg, repo, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.BlackjackService]{
+			registry.Post("/deal", func(r *http.Request, serv service.BlackjackService, req dto.BlackjackDealRequest) (dto.BlackjackTableResponse, error) {
+				return table(serv.Deal(r.Context(), req.Bet))
+			}),
+			action("/hit", service.BlackjackService.Hit),
+			action("/stand", service.BlackjackService.Stand),
+			action("/double", service.BlackjackService.Double),
+			action("/split", service.BlackjackService.Split),
+			registry.Post("/insurance", func(r *http.Request, serv service.BlackjackService, req dto.BlackjackInsuranceRequest) (dto.BlackjackTableResponse, error) {
+				return table(serv.Insurance(r.Context(), req.Take))
+			}),
+			action("/surrender", service.BlackjackService.Surrender),
+			registry.Get("/state", func(r *http.Request, serv service.BlackjackService) (dto.BlackjackTableResponse, error) {
+				return table(serv.State(r.Context()))
+			}),
+		},
+	}
+}
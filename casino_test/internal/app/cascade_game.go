@@ -0,0 +1,49 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/cascadeRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/cascade"
+	"net/http"
+)
+
+func cascadeGame() registry.Game {
+	return &registry.Definition[config.CascadeConfig, repository.CascadeRepository, service.CascadeService]{
+		ID:          cascade.GameID,
+		Name:        "Cascade slot",
+		Category:    model.GameCategorySlot,
+		Description: "Кластерный слот 7x7 с каскадами и множителями ячеек",
+		LegacyPath:  "/cascade",
+		LoadConfig:  env.NewCascadeConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.CascadeRepository {
+			return cascadeRepo.NewCascadeRepository()
+		},
+		NewService: func(cfg config.CascadeConfig, repo repository.CascadeRepository, deps registry.Deps) service.CascadeService {
+			return cascade.NewCascadeService(cfg, deps.WheelCfg, deps.BetCfg, repo, deps.Wallet, deps.Jackpots, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.CascadeService]{
+			registry.Post("/spin", func(r *http.Request, serv service.CascadeService, req dto.CascadeSpinRequest) (dto.CascadeSpinResponse, error) {
+				return registry.Reply(converter.ToCascadeSpinResponse)(serv.Spin(r.Context(), converter.ToCascadeSpin(req)))
+			}),
+			registry.Post("/buy-bonus", func(r *http.Request, serv service.CascadeService, req dto.BuyCascadeBonusRequest) (dto.BuyBonusResponse, error) {
+				return registry.Reply(converter.ToBuyBonusResponse)(serv.BuyBonus(converter.ToCascadeBuyBonus(req)))
+			}),
+			registry.Get("/check-data", func(r *http.Request, serv service.CascadeService) (dto.CascadeDataResponse, error) {
+				return registry.Reply(converter.ToCascadeDataResponse)(serv.CheckData())
+			}),
+			registry.Get("/game-info", func(r *http.Request, serv service.CascadeService) (dto.CascadeGameInfoResponse, error) {
+				return registry.Reply(converter.ToCascadeGameInfoResponse)(serv.GameInfo())
+			}),
+			registry.Action("/wheel/spin", func(r *http.Request, serv service.CascadeService) (dto.WheelSpinResponse, error) {
+				return registry.Reply(converter.ToWheelSpinResponse)(serv.WheelSpin(r.Context()))
+			}),
+		},
+	}
+}
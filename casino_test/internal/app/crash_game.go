@@ -0,0 +1,57 @@
+package app
+
+import (
+	"casino_test/internal/api"
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/crashRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/crash"
+	"context"
+	"net/http"
+)
+
+func crashGame() registry.Game {
+	return &registry.Definition[config.CrashConfig, repository.CrashRepository, service.CrashService]{
+		ID:          "crash",
+		Name:        "Crash",
+		Category:    model.GameCategoryInstant,
+		Description: "Общие для всех игроков раунды с растущим множителем и доказуемо честной точкой краша",
+		LegacyPath:  "/crash",
+		LoadConfig:  env.NewCrashConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.CrashRepository {
+			return crashRepo.NewCrashRepository()
+		},
+		NewService: func(cfg config.CrashConfig, repo repository.CrashRepository, deps registry.Deps) service.CrashService {
+			return crash.NewCrashService(cfg, repo, deps.Wallet)
+		},
+		Endpoints: []registry.Endpoint[service.CrashService]{
+			// Ответ на ставку — единственное место, где клиент получает секрет для кэшаута
+			registry.Post("/bet", func(r *http.Request, serv service.CrashService, req dto.CrashBetRequest) (dto.CrashPlacedBet, error) {
+				return registry.Reply(converter.ToCrashPlacedBetResponse)(serv.PlaceBet(r.Context(), converter.ToCrashBet(req)))
+			}),
+			registry.Post("/cashout", func(r *http.Request, serv service.CrashService, req dto.CrashCashoutRequest) (dto.CrashBet, error) {
+				return registry.Reply(converter.ToCrashBetResponse)(serv.Cashout(r.Context(), req.Token))
+			}),
+			registry.Get("/state", func(r *http.Request, serv service.CrashService) (dto.CrashStateResponse, error) {
+				return registry.Reply(converter.ToCrashStateResponse)(serv.State(r.Context()))
+			}),
+			// Лента раундов по WebSocket
+			registry.Raw(http.MethodGet, "/ws", func(serv service.CrashService, deps registry.Deps) http.HandlerFunc {
+				return api.NewCrashFeedHandler(api.CrashFeedHandlerDependencies{
+					Serv:           serv,
+					AllowedOrigins: deps.ServerCfg.AllowedOrigins(),
+				}).Feed
+			}),
+		},
+		// Раунды краш-игры идут для всех игроков независимо от запросов
+		Run: func(ctx context.Context, serv service.CrashService) {
+			serv.Run(ctx)
+		},
+	}
+}
@@ -0,0 +1,19 @@
+package app
+
+import "casino_test/internal/registry"
+
+// games подключённые игры. Новая игра добавляется сюда одним описанием —
+// реестр сам соберёт её и смонтирует маршруты под /games/{id}.
+func games() []registry.Game {
+	return []registry.Game{
+		lineGame(),
+		cascadeGame(),
+		rouletteGame(),
+		crashGame(),
+		plinkoGame(),
+		minesGame(),
+		videoPokerGame(),
+		blackjackGame(),
+		kenoGame(),
+	}
+}
@@ -0,0 +1,58 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/kenoRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/keno"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func kenoGame() registry.Game {
+	return &registry.Definition[config.KenoConfig, repository.KenoRepository, service.KenoService]{
+		ID:          "keno",
+		Name:        "Keno",
+		Category:    model.GameCategoryLottery,
+		Description: "Отметь от 1 до 10 чисел из 80; билет можно купить на несколько тиражей подряд",
+		LegacyPath:  "/keno",
+		LoadConfig:  env.NewKenoConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.KenoRepository {
+			return kenoRepo.NewKenoRepository()
+		},
+		NewService: func(cfg config.KenoConfig, repo repository.KenoRepository, deps registry.Deps) service.KenoService {
+			return keno.NewKenoService(cfg, repo, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.KenoService]{
+			registry.Post("/ticket", func(r *http.Request, serv service.KenoService, req dto.KenoTicketRequest) (dto.KenoTicketResponse, error) {
+				return registry.Reply(converter.ToKenoTicketResponse)(serv.BuyTicket(r.Context(), converter.ToKenoPurchase(req)))
+			}),
+			registry.Get("/tickets", func(r *http.Request, serv service.KenoService) (dto.KenoTicketsResponse, error) {
+				tickets, err := serv.Tickets(r.Context())
+				if err != nil {
+					return dto.KenoTicketsResponse{}, err
+				}
+				return converter.ToKenoTicketsResponse(tickets), nil
+			}),
+			registry.Get("/tickets/{id}", func(r *http.Request, serv service.KenoService) (dto.KenoTicket, error) {
+				id, err := strconv.Atoi(chi.URLParam(r, "id"))
+				if err != nil {
+					return dto.KenoTicket{}, registry.BadRequest(errors.New("invalid ticket id"))
+				}
+				return registry.Reply(converter.ToKenoTicket)(serv.Ticket(r.Context(), id))
+			}),
+			registry.Get("/game-info", func(r *http.Request, serv service.KenoService) (dto.KenoGameInfoResponse, error) {
+				return registry.Reply(converter.ToKenoGameInfoResponse)(serv.GameInfo())
+			}),
+		},
+	}
+}
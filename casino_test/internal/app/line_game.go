@@ -0,0 +1,65 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/lineRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/line"
+	"net/http"
+)
+
+func lineGame() registry.Game {
+	return &registry.Definition[config.LineConfig, repository.LineRepository, service.LineService]{
+		ID:          line.GameID,
+		Name:        "Line slot",
+		Category:    model.GameCategorySlot,
+		Description: "Слот 5x3 с линиями выплат, фриспинами, hold and win, pick-em и игрой на удвоение",
+		LegacyPath:  "/",
+		LoadConfig:  env.NewLineConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.LineRepository {
+			return lineRepo.NewLineRepository()
+		},
+		NewService: func(cfg config.LineConfig, repo repository.LineRepository, deps registry.Deps) service.LineService {
+			return line.NewLineService(cfg, deps.WheelCfg, deps.BetCfg, repo, deps.Wallet, deps.Jackpots, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.LineService]{
+			registry.Post("/spin", func(r *http.Request, serv service.LineService, req dto.LineSpinRequest) (dto.LineSpinResponse, error) {
+				return registry.Reply(converter.ToLineSpinResponse)(serv.Spin(r.Context(), converter.ToLineSpin(req)))
+			}),
+			// Респин бонуса hold and win
+			registry.Action("/hold-and-win/respin", func(r *http.Request, serv service.LineService) (dto.HoldAndWinRespinResponse, error) {
+				return registry.Reply(converter.ToHoldAndWinRespinResponse)(serv.HoldAndWinRespin(r.Context()))
+			}),
+			// Выбор ячейки в бонусе pick-em
+			registry.Post("/pick-em/pick", func(r *http.Request, serv service.LineService, req dto.PickEmPickRequest) (dto.PickEmPickResponse, error) {
+				return registry.Reply(converter.ToPickEmPickResponse)(serv.PickEmPick(r.Context(), req.Index))
+			}),
+			// Вращение колеса фортуны
+			registry.Action("/wheel/spin", func(r *http.Request, serv service.LineService) (dto.WheelSpinResponse, error) {
+				return registry.Reply(converter.ToWheelSpinResponse)(serv.WheelSpin(r.Context()))
+			}),
+			// Игра на удвоение
+			registry.Post("/gamble", func(r *http.Request, serv service.LineService, req dto.GambleRequest) (dto.GambleResponse, error) {
+				return registry.Reply(converter.ToGambleResponse)(serv.Gamble(r.Context(), req.Choice))
+			}),
+			registry.Action("/collect", func(r *http.Request, serv service.LineService) (dto.CollectResponse, error) {
+				return registry.Reply(converter.ToCollectResponse)(serv.Collect(r.Context()))
+			}),
+			registry.Post("/buy-bonus", func(r *http.Request, serv service.LineService, req dto.BuyBonusRequest) (map[string]string, error) {
+				if err := serv.BuyBonus(req.Amount); err != nil {
+					return nil, err
+				}
+				return map[string]string{"result": "ok"}, nil
+			}),
+			registry.Get("/check-data", func(r *http.Request, serv service.LineService) (dto.DataResponse, error) {
+				return registry.Reply(converter.ToDataResponse)(serv.CheckData())
+			}),
+		},
+	}
+}
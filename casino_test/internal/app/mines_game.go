@@ -0,0 +1,47 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/minesRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/mines"
+	"net/http"
+)
+
+func minesGame() registry.Game {
+	return &registry.Definition[config.MinesConfig, repository.MinesRepository, service.MinesService]{
+		ID:          "mines",
+		Name:        "Mines",
+		Category:    model.GameCategoryInstant,
+		Description: "Поле 5x5: открывай клетки, обходя мины, и забирай выигрыш в любой момент",
+		LegacyPath:  "/mines",
+		LoadConfig:  env.NewMinesConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.MinesRepository {
+			return minesRepo.NewMinesRepository()
+		},
+		NewService: func(cfg config.MinesConfig, repo repository.MinesRepository, deps registry.Deps) service.MinesService {
+			return mines.NewMinesService(cfg, repo, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.MinesService]{
+			registry.Post("/start", func(r *http.Request, serv service.MinesService, req dto.MinesStartRequest) (dto.MinesStateResponse, error) {
+				return registry.Reply(converter.ToMinesStateResponse)(serv.Start(r.Context(), converter.ToMinesStart(req)))
+			}),
+			registry.Post("/reveal", func(r *http.Request, serv service.MinesService, req dto.MinesRevealRequest) (dto.MinesStateResponse, error) {
+				return registry.Reply(converter.ToMinesStateResponse)(serv.Reveal(r.Context(), req.PlayerID, req.Tile))
+			}),
+			registry.Post("/cashout", func(r *http.Request, serv service.MinesService, req dto.MinesCashoutRequest) (dto.MinesStateResponse, error) {
+				return registry.Reply(converter.ToMinesStateResponse)(serv.Cashout(r.Context(), req.PlayerID))
+			}),
+			// Последний раунд игрока: GET /mines/state?player_id=...
+			registry.Get("/state", func(r *http.Request, serv service.MinesService) (dto.MinesStateResponse, error) {
+				return registry.Reply(converter.ToMinesStateResponse)(serv.State(r.Context(), r.URL.Query().Get("player_id")))
+			}),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/service"
+	"casino_test/internal/service/plinko"
+	"net/http"
+)
+
+func plinkoGame() registry.Game {
+	return &registry.Definition[config.PlinkoConfig, struct{}, service.PlinkoService]{
+		ID:          "plinko",
+		Name:        "Plinko",
+		Category:    model.GameCategoryInstant,
+		Description: "Шарик через 8–16 рядов колышков, три уровня риска",
+		LegacyPath:  "/plinko",
+		LoadConfig:  env.NewPlinkoConfigFromYAML,
+		NewService: func(cfg config.PlinkoConfig, _ struct{}, deps registry.Deps) service.PlinkoService {
+			return plinko.NewPlinkoService(cfg, deps.BetCfg, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.PlinkoService]{
+			registry.Post("/drop", func(r *http.Request, serv service.PlinkoService, req dto.PlinkoDropRequest) (dto.PlinkoDropResponse, error) {
+				return registry.Reply(converter.ToPlinkoDropResponse)(serv.Drop(r.Context(), converter.ToPlinkoDrop(req)))
+			}),
+			registry.Get("/game-info", func(r *http.Request, serv service.PlinkoService) (dto.PlinkoGameInfoResponse, error) {
+				return registry.Reply(converter.ToPlinkoGameInfoResponse)(serv.GameInfo())
+			}),
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/rouletteRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/roulette"
+	"net/http"
+)
+
+func rouletteGame() registry.Game {
+	return &registry.Definition[config.RouletteConfig, repository.RouletteRepository, service.RouletteService]{
+		ID:          "roulette",
+		Name:        "European roulette",
+		Category:    model.GameCategoryTable,
+		Description: "Европейская рулетка с одним зеро и всеми стандартными ставками",
+		LegacyPath:  "/roulette",
+		LoadConfig:  env.NewRouletteConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.RouletteRepository {
+			return rouletteRepo.NewRouletteRepository()
+		},
+		NewService: func(cfg config.RouletteConfig, repo repository.RouletteRepository, deps registry.Deps) service.RouletteService {
+			return roulette.NewRouletteService(cfg, repo, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.RouletteService]{
+			registry.Post("/spin", func(r *http.Request, serv service.RouletteService, req dto.RouletteSpinRequest) (dto.RouletteSpinResponse, error) {
+				return registry.Reply(converter.ToRouletteSpinResponse)(serv.Spin(r.Context(), converter.ToRouletteBets(req)))
+			}),
+			registry.Get("/check-data", func(r *http.Request, serv service.RouletteService) (dto.RouletteDataResponse, error) {
+				return registry.Reply(converter.ToRouletteDataResponse)(serv.CheckData())
+			}),
+		},
+	}
+}
@@ -4,102 +4,164 @@ import (
 	"casino_test/internal/api"
 	"casino_test/internal/config"
 	"casino_test/internal/config/env"
+	"casino_test/internal/registry"
 	"casino_test/internal/repository"
-	"casino_test/internal/repository/cascadeRepo"
-	"casino_test/internal/repository/lineRepo"
+	"casino_test/internal/repository/jackpotRepo"
+	"casino_test/internal/repository/walletRepo"
 	"casino_test/internal/service"
-	"casino_test/internal/service/cascade"
-	"casino_test/internal/service/line"
+	"casino_test/internal/service/jackpot"
+	"casino_test/internal/service/wallet"
+	"casino_test/pkg/rng"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/cors"
 )
 
 type ServiceProvider struct {
-	lineCfg    config.LineConfig
-	repository repository.LineRepository
-	service    service.LineService
-	handler    *api.Handler
-	// Cascade bits
-	cascadeCfg  config.CascadeConfig
-	cascadeRepo repository.CascadeRepository
-	cascadeServ service.CascadeService
-	cascadeHand *api.CascadeHandler
-	router      chi.Router
+	// Настройки сервера (разрешённые источники)
+	serverCfg config.ServerConfig
+	// Колёса фортуны (общие для игр)
+	wheelCfg config.WheelConfig
+	// Лестница ставок и ГСЧ (общие для игр)
+	betCfg config.BetConfig
+	rng    rng.RNG
+	// Общий кошелёк и джекпоты
+	wallet      repository.WalletRepository
+	walletServ  service.WalletService
+	walletHand  *api.WalletHandler
+	jackpotCfg  config.JackpotConfig
+	jackpotRepo repository.JackpotRepository
+	jackpotServ service.JackpotService
+	jackpotHand *api.JackpotHandler
+	// Реестр игр
+	registry  *registry.Registry
+	gamesHand *api.GamesHandler
+	router    chi.Router
 }
 
 func newServiceProvider() *ServiceProvider {
 	return &ServiceProvider{}
 }
 
-func (sp *ServiceProvider) LineCfg() config.LineConfig {
-	if sp.lineCfg == nil {
-		cfg, err := env.NewLineConfigFromYAML("config.yaml")
+func (sp *ServiceProvider) ServerCfg() config.ServerConfig {
+	if sp.serverCfg == nil {
+		cfg, err := env.NewServerConfigFromYAML("config.yaml")
 		if err != nil {
-			panic("failed to get line config: " + err.Error())
+			panic("failed to get server config: " + err.Error())
 		}
+		sp.serverCfg = cfg
+	}
+	return sp.serverCfg
+}
 
-		sp.lineCfg = cfg
+func (sp *ServiceProvider) WheelCfg() config.WheelConfig {
+	if sp.wheelCfg == nil {
+		cfg, err := env.NewWheelConfigFromYAML("config.yaml")
+		if err != nil {
+			panic("failed to get wheel config: " + err.Error())
+		}
+		sp.wheelCfg = cfg
+	}
+	return sp.wheelCfg
+}
+
+func (sp *ServiceProvider) BetCfg() config.BetConfig {
+	if sp.betCfg == nil {
+		cfg, err := env.NewBetConfigFromYAML("config.yaml")
+		if err != nil {
+			panic("failed to get bet config: " + err.Error())
+		}
+		sp.betCfg = cfg
 	}
+	return sp.betCfg
+}
 
-	return sp.lineCfg
+func (sp *ServiceProvider) RNG() rng.RNG {
+	if sp.rng == nil {
+		sp.rng = rng.New()
+	}
+	return sp.rng
 }
 
-func (sp *ServiceProvider) Repository() repository.LineRepository {
-	if sp.repository == nil {
-		sp.repository = lineRepo.NewLineRepository()
+func (sp *ServiceProvider) Wallet() repository.WalletRepository {
+	if sp.wallet == nil {
+		sp.wallet = walletRepo.NewWalletRepository()
 	}
-	return sp.repository
+	return sp.wallet
 }
 
-func (sp *ServiceProvider) Service() service.LineService {
-	if sp.service == nil {
-		sp.service = line.NewLineService(sp.LineCfg(), sp.Repository())
+func (sp *ServiceProvider) WalletService() service.WalletService {
+	if sp.walletServ == nil {
+		sp.walletServ = wallet.NewWalletService(sp.Wallet())
 	}
+	return sp.walletServ
+}
 
-	return sp.service
+func (sp *ServiceProvider) WalletHandler() *api.WalletHandler {
+	if sp.walletHand == nil {
+		sp.walletHand = api.NewWalletHandler(api.WalletHandlerDependencies{Serv: sp.WalletService()})
+	}
+	return sp.walletHand
 }
 
-func (sp *ServiceProvider) CascadeCfg() config.CascadeConfig {
-	if sp.cascadeCfg == nil {
-		cfg, err := env.NewCascadeConfigFromYAML("config.yaml")
+func (sp *ServiceProvider) JackpotCfg() config.JackpotConfig {
+	if sp.jackpotCfg == nil {
+		cfg, err := env.NewJackpotConfigFromYAML("config.yaml")
 		if err != nil {
-			panic("failed to get cascade config: " + err.Error())
+			panic("failed to get jackpot config: " + err.Error())
 		}
-		sp.cascadeCfg = cfg
+		sp.jackpotCfg = cfg
 	}
-	return sp.cascadeCfg
+	return sp.jackpotCfg
 }
 
-func (sp *ServiceProvider) CascadeRepository() repository.CascadeRepository {
-	if sp.cascadeRepo == nil {
-		sp.cascadeRepo = cascadeRepo.NewCascadeRepository()
+func (sp *ServiceProvider) JackpotRepository() repository.JackpotRepository {
+	if sp.jackpotRepo == nil {
+		sp.jackpotRepo = jackpotRepo.NewJackpotRepository()
 	}
-	return sp.cascadeRepo
+	return sp.jackpotRepo
 }
 
-func (sp *ServiceProvider) CascadeService() service.CascadeService {
-	if sp.cascadeServ == nil {
-		sp.cascadeServ = cascade.NewCascadeService(sp.CascadeCfg(), sp.CascadeRepository())
+func (sp *ServiceProvider) JackpotService() service.JackpotService {
+	if sp.jackpotServ == nil {
+		sp.jackpotServ = jackpot.NewJackpotService(sp.JackpotCfg(), sp.JackpotRepository(), sp.Wallet(), sp.RNG())
 	}
-	return sp.cascadeServ
+	return sp.jackpotServ
 }
 
-func (sp *ServiceProvider) CascadeHandler() *api.CascadeHandler {
-	if sp.cascadeHand == nil {
-		sp.cascadeHand = api.NewCascadeHandler(api.CascadeHandlerDependencies{Serv: sp.CascadeService()})
+func (sp *ServiceProvider) JackpotHandler() *api.JackpotHandler {
+	if sp.jackpotHand == nil {
+		sp.jackpotHand = api.NewJackpotHandler(api.JackpotHandlerDependencies{Serv: sp.JackpotService()})
 	}
-	return sp.cascadeHand
+	return sp.jackpotHand
 }
 
-func (sp *ServiceProvider) Handler() *api.Handler {
-	if sp.handler == nil {
-		sp.handler = api.NewHandler(api.HandlerDependencies{
-			Serv: sp.Service(),
+func (sp *ServiceProvider) Registry() *registry.Registry {
+	if sp.registry == nil {
+		reg := registry.New(registry.Deps{
+			ConfigPath: "config.yaml",
+			ServerCfg:  sp.ServerCfg(),
+			Wallet:     sp.Wallet(),
+			RNG:        sp.RNG(),
+			BetCfg:     sp.BetCfg(),
+			WheelCfg:   sp.WheelCfg(),
+			Jackpots:   sp.JackpotService(),
 		})
+		for _, game := range games() {
+			if err := reg.Register(game); err != nil {
+				panic("failed to register games: " + err.Error())
+			}
+		}
+		sp.registry = reg
 	}
+	return sp.registry
+}
 
-	return sp.handler
+func (sp *ServiceProvider) GamesHandler() *api.GamesHandler {
+	if sp.gamesHand == nil {
+		sp.gamesHand = api.NewGamesHandler(api.GamesHandlerDependencies{Catalog: sp.Registry()})
+	}
+	return sp.gamesHand
 }
 
 func (sp *ServiceProvider) Router() chi.Router {
@@ -107,8 +169,7 @@ func (sp *ServiceProvider) Router() chi.Router {
 		r := chi.NewRouter()
 
 		r.Use(cors.Handler(cors.Options{
-			// AllowedOrigins:   []string{"https://foo.com"}, // Use this to allow specific origin hosts
-			AllowedOrigins: []string{"https://*", "http://*"},
+			AllowedOrigins: sp.ServerCfg().AllowedOrigins(),
 			// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
 			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
@@ -117,24 +178,22 @@ func (sp *ServiceProvider) Router() chi.Router {
 			MaxAge:           300, // Maximum value not ignored by any of major browsers
 		}))
 
-		h := sp.Handler()
-		// Регистрируем маршрут /spin
-		r.Post("/spin", h.Spin)
-		// Регистрируем маршрут для покупки бонуса
-		r.Post("/buy-bonus", h.BuyBonus)
-		// Регистрируем маршрут для депозита
-		r.Post("/deposit", h.Deposit)
-		// Регистрируем маршрут для проверки данных пользователя
-		r.Get("/check-data", h.CheckData)
-
-		// Cascade endpoints
-		ch := sp.CascadeHandler()
-		r.Route("/cascade", func(rr chi.Router) {
-			rr.Post("/spin", ch.Spin)
-			rr.Post("/buy-bonus", ch.BuyBonus)
-			rr.Post("/deposit", ch.Deposit)
-			rr.Get("/check-data", ch.CheckData)
+		// Текущие суммы джекпотов (общие для всех игр)
+		r.Get("/jackpots", sp.JackpotHandler().Pools)
+
+		// Общий кошелёк: депозит и баланс одни на все игры
+		wh := sp.WalletHandler()
+		r.Route("/wallet", func(rr chi.Router) {
+			rr.Post("/deposit", wh.Deposit)
+			rr.Get("/check-data", wh.CheckData)
 		})
+		// Старые адреса депозита линейного и каскадного слота
+		r.Post("/deposit", wh.Deposit)
+		r.Post("/cascade/deposit", wh.Deposit)
+
+		// Каталог игр и их маршруты под /games/{id} (и по старым адресам)
+		r.Get("/games", sp.GamesHandler().List)
+		sp.Registry().Mount(r)
 
 		sp.router = r
 	}
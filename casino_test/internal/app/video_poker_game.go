@@ -0,0 +1,43 @@
+package app
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
+	"casino_test/internal/config/env"
+	"casino_test/internal/converter"
+	"casino_test/internal/model"
+	"casino_test/internal/registry"
+	"casino_test/internal/repository"
+	"casino_test/internal/repository/videoPokerRepo"
+	"casino_test/internal/service"
+	"casino_test/internal/service/videopoker"
+	"net/http"
+)
+
+func videoPokerGame() registry.Game {
+	return &registry.Definition[config.VideoPokerConfig, repository.VideoPokerRepository, service.VideoPokerService]{
+		ID:          "video-poker",
+		Name:        "Jacks or Better",
+		Category:    model.GameCategoryCards,
+		Description: "Видеопокер: раздача, замена карт, выплата от пары валетов",
+		LegacyPath:  "/video-poker",
+		LoadConfig:  env.NewVideoPokerConfigFromYAML,
+		NewRepository: func(deps registry.Deps) repository.VideoPokerRepository {
+			return videoPokerRepo.NewVideoPokerRepository()
+		},
+		NewService: func(cfg config.VideoPokerConfig, repo repository.VideoPokerRepository, deps registry.Deps) service.VideoPokerService {
+			return videopoker.NewVideoPokerService(cfg, repo, deps.Wallet, deps.RNG)
+		},
+		Endpoints: []registry.Endpoint[service.VideoPokerService]{
+			registry.Post("/deal", func(r *http.Request, serv service.VideoPokerService, req dto.VideoPokerDealRequest) (dto.VideoPokerDealResponse, error) {
+				return registry.Reply(converter.ToVideoPokerDealResponse)(serv.Deal(r.Context(), req.Bet))
+			}),
+			registry.Post("/draw", func(r *http.Request, serv service.VideoPokerService, req dto.VideoPokerDrawRequest) (dto.VideoPokerDrawResponse, error) {
+				return registry.Reply(converter.ToVideoPokerDrawResponse)(serv.Draw(r.Context(), req.Held))
+			}),
+			registry.Get("/check-data", func(r *http.Request, serv service.VideoPokerService) (dto.VideoPokerDataResponse, error) {
+				return registry.Reply(converter.ToVideoPokerDataResponse)(serv.CheckData())
+			}),
+		},
+	}
+}
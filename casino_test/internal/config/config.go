@@ -1,15 +1,324 @@
 package config
 
+// Размер поля линейного слота
+const (
+	LineReels = 5
+	LineRows  = 3
+)
+
+// Способы расчёта выигрышей линейного слота
+const (
+	LineEvaluatorLines = "lines" // фиксированные линии выплат
+	LineEvaluatorWays  = "ways"  // все пути (243 ways): символ на соседних барабанах с первого
+)
+
+// Направление выплат линейного слота
+const (
+	PayDirectionLeftToRight = "ltr"  // слева направо, с первого барабана
+	PayDirectionRightToLeft = "rtl"  // справа налево, с последнего барабана
+	PayDirectionBoth        = "both" // в обе стороны
+)
+
+// Как платить полную линию (5 в ряд) при выплатах в обе стороны
+const (
+	FullLinePayOnce  = "once"  // один раз
+	FullLinePayTwice = "twice" // дважды — слева и справа
+)
+
+// Что запускают скаттеры линейного слота
+const (
+	ScatterFeatureFreeSpins = "free_spins" // фриспины по таблице line_free_spins_by_scatter
+	ScatterFeaturePickEm    = "pick_em"    // бонусная игра с выбором скрытых призов
+	ScatterFeatureWheel     = "wheel"      // колесо фортуны (общее для всех игр)
+)
+
+// Типы призов бонусной игры pick-em
+const (
+	PickEmPrizeCredit     = "credit"     // выигрыш в сотых долях ставки
+	PickEmPrizeMultiplier = "multiplier" // множитель к собранным выигрышам
+	PickEmPrizeExtraPick  = "extra_pick" // дополнительный выбор
+	PickEmPrizeCollect    = "collect"    // игра заканчивается, собранное выплачивается
+)
+
+// PickEmPrize приз, спрятанный за одной из ячеек pick-em
+type PickEmPrize struct {
+	Type  string `yaml:"type"`
+	Value int    `yaml:"value"` // Сотые доли ставки для credit, множитель для multiplier, число выборов для extra_pick
+}
+
 type LineConfig interface {
 	SymbolWeights() map[string]int
 	WildChance() float64
 	FreeSpinsByScatter() map[int]int
 	PayoutTable() map[string]map[int]int
+	WildPayoutTable() map[int]int
+	Paylines() [][]int
+	FreeSpinStickyWilds() bool
+	FreeSpinWildMultipliers() map[int]int
+	FreeSpinMultiplierStep() int
+	HoldAndWinTrigger() int
+	HoldAndWinRespins() int
+	HoldAndWinLandChance() float64
+	MoneyValues() map[int]int
+	MoneyJackpotWeights() map[string]int
+	MoneyJackpots() map[string]int
+	ScatterFeature() string
+	PickEmPicks() int
+	PickEmPrizes() []PickEmPrize
+	GambleEnabled() bool
+	GambleMaxSteps() int
+	GambleLimitXBet() int
+	TumbleEnabled() bool
+	TumbleMultiplierStep() int
+	Evaluator() string
+	WaysPayDivisor() int
+	PayDirection() string
+	BothWaysFullLine() string
+	MaxWinXBet() int
+}
+
+// Правила роста множителя ячейки каскадного слота
+const (
+	MultiplierGrowthDouble = "double" // каждое попадание удваивает множитель
+	MultiplierGrowthAdd    = "add"    // каждое попадание прибавляет фиксированный шаг
+)
+
+// Способы объединения множителей ячеек кластера
+const (
+	MultiplierCombineAverage = "average" // среднее (целочисленное деление)
+	MultiplierCombineSum     = "sum"     // сумма множителей отмеченных ячеек
+	MultiplierCombineProduct = "product" // произведение множителей отмеченных ячеек
+)
+
+// Способы поиска выигрышей каскадного слота
+const (
+	CascadeEvaluatorCluster     = "cluster"      // связные кластеры (BFS по соседям)
+	CascadeEvaluatorPayAnywhere = "pay_anywhere" // N+ одинаковых символов в любом месте поля
+)
+
+// CascadeBonusBuy продукт покупки бонуса каскадного слота
+type CascadeBonusBuy struct {
+	ID              string `yaml:"id"`
+	Name            string `yaml:"name"`
+	PriceXBet       int    `yaml:"price_x_bet"`      // Стоимость в кратности ставки
+	FreeSpins       int    `yaml:"free_spins"`       // Сколько фриспинов выдаётся
+	StartMultiplier int    `yaml:"start_multiplier"` // Стартовый множитель на всех ячейках (1 — чистое поле)
 }
 
 type CascadeConfig interface {
 	SymbolWeights() map[int]int
 	BonusProbPerColumn() float64
+	BonusProbPerColumnFreeSpins() float64
+	BonusMaxPerColumn() int
+	BonusMaxPerBoard() int
 	BonusAwards() map[int]int
+	BonusBuys() []CascadeBonusBuy
 	PayoutTable() map[int]int
+	MaxWinXBet() int
+
+	Evaluator() string
+	PayAnywhereMinCount() int
+
+	ScatterFeature() string
+
+	MultiplierStart() int
+	MultiplierGrowth() string
+	MultiplierStep() int
+	MultiplierMax() int
+	MultiplierCombine() string
+	MultiplierResetEachFreeSpin() bool
+}
+
+// Типы секторов колеса фортуны
+const (
+	WheelSegmentCredit     = "credit"     // выигрыш в кратности ставки, колесо останавливается
+	WheelSegmentMultiplier = "multiplier" // множитель к следующему выигрышу, колесо крутится ещё раз
+	WheelSegmentFreeSpins  = "free_spins" // фриспины в игре, запустившей колесо
+	WheelSegmentLevelUp    = "level_up"   // переход на внутреннее колесо с призами крупнее
+)
+
+// WheelSegment сектор колеса
+type WheelSegment struct {
+	Type   string `yaml:"type"`
+	Value  int    `yaml:"value"`  // Кратность ставки, множитель или число фриспинов
+	Weight int    `yaml:"weight"` // Вес выпадения
+}
+
+// Wheel колесо фортуны; Next — колесо, на которое ведёт сектор level_up
+type Wheel struct {
+	ID       string         `yaml:"id"`
+	Segments []WheelSegment `yaml:"segments"`
+	Next     string         `yaml:"next"`
+}
+
+// WheelConfig колёса фортуны, общие для всех игр
+type WheelConfig interface {
+	StartWheel() string
+	Wheel(id string) (Wheel, bool)
+}
+
+// Условия выпадения джекпота
+const (
+	JackpotTriggerMustDrop = "must_drop" // случайная точка между seed и must_drop_by, выпадает при её достижении
+	JackpotTriggerSymbol   = "symbol"    // N+ скаттеров (бонусных символов) в платном спине
+)
+
+// JackpotTier уровень прогрессивного джекпота
+type JackpotTier struct {
+	ID           string  `yaml:"id"`
+	Contribution float64 `yaml:"contribution"` // Доля каждой ставки, идущая в пул (0.01 = 1%)
+	Seed         int     `yaml:"seed"`         // Стартовое значение пула
+	Trigger      string  `yaml:"trigger"`
+	MustDropBy   int     `yaml:"must_drop_by"` // Пул обязательно выпадет, не дорастая до этого значения
+	// SymbolCounts сколько скаттеров нужно для выпадения в каждой игре (ID из реестра).
+	// Поля игр разные (5x3, 7x7), поэтому общий порог не годится; игры нет в списке — символами пул не выпадает
+	SymbolCounts map[string]int `yaml:"symbol_counts"`
+}
+
+type JackpotConfig interface {
+	Tiers() []JackpotTier
+}
+
+// Виды ставок в рулетке
+const (
+	RouletteBetStraight = "straight" // одно число, 35:1
+	RouletteBetSplit    = "split"    // два соседних числа, 17:1
+	RouletteBetStreet   = "street"   // ряд из трёх чисел, 11:1
+	RouletteBetCorner   = "corner"   // четыре числа в квадрате, 8:1
+	RouletteBetSixLine  = "six_line" // два соседних ряда, 5:1
+	RouletteBetDozen    = "dozen"    // 1-12, 13-24, 25-36, 2:1
+	RouletteBetColumn   = "column"   // колонка из 12 чисел, 2:1
+	RouletteBetRed      = "red"      // 1:1
+	RouletteBetBlack    = "black"    // 1:1
+	RouletteBetOdd      = "odd"      // 1:1
+	RouletteBetEven     = "even"     // 1:1
+	RouletteBetLow      = "low"      // 1-18, 1:1
+	RouletteBetHigh     = "high"     // 19-36, 1:1
+)
+
+// RouletteConfig лимиты стола европейской рулетки
+type RouletteConfig interface {
+	MinBet() int         // Минимальная ставка на одну позицию
+	MaxInsideBet() int   // Максимум на одну внутреннюю ставку (числа)
+	MaxOutsideBet() int  // Максимум на одну внешнюю ставку (дюжины, колонки, равные шансы)
+	MaxTotalBet() int    // Максимум всех ставок за спин
+	MaxBetsPerSpin() int // Максимум позиций за спин
+}
+
+// CrashConfig настройки краш-игры
+type CrashConfig interface {
+	HouseEdge() float64     // Преимущество казино (0.01 = 1%)
+	BettingSeconds() int    // Сколько длится приём ставок перед раундом
+	PauseSeconds() int      // Пауза после краша перед следующим раундом
+	TickMillis() int        // Как часто рассылается множитель
+	GrowthRate() float64    // Скорость роста: множитель = e^(rate * секунды)
+	MaxMultiplier() float64 // Потолок множителя (раунд крашится на нём)
+	MinBet() int
+	MaxBet() int
+}
+
+// ServerConfig настройки HTTP-сервера
+type ServerConfig interface {
+	// AllowedOrigins источники, с которых браузеру разрешено обращаться к API и ленте WebSocket
+	AllowedOrigins() []string
+}
+
+// BetConfig лестница ставок, общая для всех игр
+type BetConfig interface {
+	// BetLadder допустимые ставки по возрастанию (пусто — ставка не ограничена лестницей)
+	BetLadder() []int
+	IsAllowedBet(bet int) bool
+}
+
+// Допустимое число рядов колышков в плинко
+const (
+	PlinkoMinRows = 8
+	PlinkoMaxRows = 16
+)
+
+// Уровни риска плинко
+const (
+	PlinkoRiskLow    = "low"
+	PlinkoRiskMedium = "medium"
+	PlinkoRiskHigh   = "high"
+)
+
+// PlinkoConfig таблицы выплат плинко
+type PlinkoConfig interface {
+	// Multipliers множители лунок слева направо (rows+1 значений) для уровня риска и числа рядов
+	Multipliers(risk string, rows int) ([]float64, bool)
+	MinBet() int
+	MaxBet() int
+}
+
+// Поле игры Mines: 5x5
+const (
+	MinesSize  = 5
+	MinesTiles = MinesSize * MinesSize
+)
+
+// MinesConfig настройки игры Mines
+type MinesConfig interface {
+	HouseEdge() float64 // Преимущество казино (0.01 = 1%)
+	MinMines() int
+	MaxMines() int
+	MinBet() int
+	MaxBet() int
+}
+
+// Комбинации видеопокера Jacks or Better (ключи таблицы выплат)
+const (
+	PokerRoyalFlush    = "royal_flush"
+	PokerStraightFlush = "straight_flush"
+	PokerFourOfAKind   = "four_of_a_kind"
+	PokerFullHouse     = "full_house"
+	PokerFlush         = "flush"
+	PokerStraight      = "straight"
+	PokerThreeOfAKind  = "three_of_a_kind"
+	PokerTwoPair       = "two_pair"
+	PokerJacksOrBetter = "jacks_or_better" // пара валетов или старше
+)
+
+// VideoPokerConfig настройки видеопокера
+type VideoPokerConfig interface {
+	// PayTable выплата за комбинацию в кратности ставки (вместе со ставкой)
+	PayTable() map[string]int
+	MinBet() int
+	MaxBet() int
+}
+
+// Выплата за блэкджек
+const (
+	BlackjackPays3To2 = "3:2"
+	BlackjackPays6To5 = "6:5"
+)
+
+// BlackjackConfig правила стола блэкджека
+type BlackjackConfig interface {
+	Decks() int             // Колод в шузе
+	ShufflePoint() float64  // Доля шуза, после раздачи которой колоды перемешиваются (0.75 = 3/4)
+	DealerHitsSoft17() bool // H17 — дилер берёт на мягких 17, S17 — стоит
+	BlackjackPays() string  // 3:2 или 6:5
+	MaxHands() int          // Сколько рук можно получить сплитами
+	DoubleAfterSplit() bool // Можно ли удваивать после сплита
+	Surrender() bool        // Поздняя сдача (после проверки блэкджека у дилера)
+	MinBet() int
+	MaxBet() int
+}
+
+// Кено: числа 1..80, в тираже 20 шаров, игрок отмечает от 1 до 10 чисел
+const (
+	KenoNumbers  = 80
+	KenoDrawn    = 20
+	KenoMinPicks = 1
+	KenoMaxPicks = 10
+)
+
+// KenoConfig настройки кено
+type KenoConfig interface {
+	// PayTable выплата в кратности ставки: число отмеченных -> число совпадений -> множитель
+	PayTable() map[int]map[int]float64
+	MaxDraws() int // Сколько тиражей подряд можно купить одним билетом
+	MinBet() int   // Ставка на один тираж
+	MaxBet() int
 }
@@ -0,0 +1,57 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+type betConfig struct {
+	Ladder []int `yaml:"bet_ladder"`
+}
+
+func NewBetConfigFromYAML(path string) (config.BetConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg betConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет, что ступени лестницы положительные и идут по возрастанию без повторов
+func (cfg *betConfig) validate() error {
+	for i, bet := range cfg.Ladder {
+		if bet <= 0 {
+			return errors.New("bet ladder values must be positive")
+		}
+		if i > 0 && bet <= cfg.Ladder[i-1] {
+			return errors.New("bet ladder must be strictly ascending")
+		}
+	}
+	return nil
+}
+
+func (cfg *betConfig) BetLadder() []int {
+	return cfg.Ladder
+}
+
+func (cfg *betConfig) IsAllowedBet(bet int) bool {
+	if bet <= 0 {
+		return false
+	}
+	if len(cfg.Ladder) == 0 {
+		return true
+	}
+	_, found := slices.BinarySearch(cfg.Ladder, bet)
+	return found
+}
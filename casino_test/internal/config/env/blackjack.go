@@ -0,0 +1,120 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Правила блэкджека по умолчанию
+const (
+	defaultBlackjackDecks        = 6
+	defaultBlackjackShufflePoint = 0.75
+	defaultBlackjackPays         = config.BlackjackPays3To2
+	defaultBlackjackMaxHands     = 4
+	defaultBlackjackMinBet       = 1
+	defaultBlackjackMaxBet       = 5000
+)
+
+type blackjackConfig struct {
+	DecksVal     int     `yaml:"blackjack_decks"`
+	Shuffle      float64 `yaml:"blackjack_shuffle_point"`
+	HitSoft17    bool    `yaml:"blackjack_dealer_hits_soft_17"`
+	Pays         string  `yaml:"blackjack_pays"`
+	Hands        int     `yaml:"blackjack_max_hands"`
+	DAS          bool    `yaml:"blackjack_double_after_split"`
+	SurrenderVal bool    `yaml:"blackjack_surrender"`
+	MinBetVal    int     `yaml:"blackjack_min_bet"`
+	MaxBetVal    int     `yaml:"blackjack_max_bet"`
+}
+
+func NewBlackjackConfigFromYAML(path string) (config.BlackjackConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg blackjackConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проставляет правила по умолчанию и проверяет настройки
+func (cfg *blackjackConfig) validate() error {
+	if cfg.DecksVal == 0 {
+		cfg.DecksVal = defaultBlackjackDecks
+	}
+	if cfg.Shuffle == 0 {
+		cfg.Shuffle = defaultBlackjackShufflePoint
+	}
+	if cfg.Pays == "" {
+		cfg.Pays = defaultBlackjackPays
+	}
+	if cfg.Hands == 0 {
+		cfg.Hands = defaultBlackjackMaxHands
+	}
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultBlackjackMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultBlackjackMaxBet
+	}
+	if cfg.DecksVal < 1 || cfg.DecksVal > 8 {
+		return errors.New("blackjack decks must be between 1 and 8")
+	}
+	if cfg.Shuffle <= 0 || cfg.Shuffle >= 1 {
+		return errors.New("blackjack shuffle point must be within (0, 1)")
+	}
+	if cfg.Pays != config.BlackjackPays3To2 && cfg.Pays != config.BlackjackPays6To5 {
+		return errors.New("blackjack pays must be 3:2 or 6:5")
+	}
+	if cfg.Hands < 1 {
+		return errors.New("blackjack max hands must be positive")
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("blackjack bet limits are invalid")
+	}
+	return nil
+}
+
+func (cfg *blackjackConfig) Decks() int {
+	return cfg.DecksVal
+}
+
+func (cfg *blackjackConfig) ShufflePoint() float64 {
+	return cfg.Shuffle
+}
+
+func (cfg *blackjackConfig) DealerHitsSoft17() bool {
+	return cfg.HitSoft17
+}
+
+func (cfg *blackjackConfig) BlackjackPays() string {
+	return cfg.Pays
+}
+
+func (cfg *blackjackConfig) MaxHands() int {
+	return cfg.Hands
+}
+
+func (cfg *blackjackConfig) DoubleAfterSplit() bool {
+	return cfg.DAS
+}
+
+func (cfg *blackjackConfig) Surrender() bool {
+	return cfg.SurrenderVal
+}
+
+func (cfg *blackjackConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *blackjackConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
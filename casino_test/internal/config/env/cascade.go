@@ -2,16 +2,52 @@ package env
 
 import (
 	"casino_test/internal/config"
+	"errors"
+	"fmt"
 	"os"
 
 	"gopkg.in/yaml.v3"
 )
 
+// Значения множителей по умолчанию (классическая механика: x2, x4, ..., x128)
+const (
+	defaultMultiplierStart = 2
+	defaultMultiplierStep  = 2
+	defaultMultiplierMax   = 128
+
+	// Ограничение максимального выигрыша (в кратности ставки)
+	defaultMaxWinXBet = 10000
+
+	// Стандартная покупка бонуса: 10 фриспинов за 100 ставок
+	defaultBonusBuyPrice     = 100
+	defaultBonusBuyFreeSpins = 10
+
+	// Минимум символов для выплаты в режиме pay anywhere
+	defaultPayAnywhereMinCount = 8
+)
+
 type cascadeConfig struct {
-	SymbolWeightsData map[int]int `yaml:"cascade_symbol_weights"`
-	BonusPerColumn    float64     `yaml:"cascade_bonus_per_column"`
-	BonusAwardsData   map[int]int `yaml:"cascade_bonus_awards"`
-	PayTable          map[int]int `yaml:"cascade_pay_table"`
+	SymbolWeightsData map[int]int              `yaml:"cascade_symbol_weights"`
+	BonusPerColumn    float64                  `yaml:"cascade_bonus_per_column"`
+	BonusPerColumnFS  *float64                 `yaml:"cascade_bonus_per_column_free_spins"` // nil — как в базовой игре
+	BonusMaxColumn    int                      `yaml:"cascade_bonus_max_per_column"`
+	BonusMaxBoard     int                      `yaml:"cascade_bonus_max_per_board"`
+	BonusAwardsData   map[int]int              `yaml:"cascade_bonus_awards"`
+	BonusBuysData     []config.CascadeBonusBuy `yaml:"cascade_bonus_buys"`
+	PayTable          map[int]int              `yaml:"cascade_pay_table"`
+	MaxWin            int                      `yaml:"cascade_max_win_x_bet"`
+
+	ScatterFeatureVal string `yaml:"cascade_scatter_feature"`
+
+	EvaluatorName  string `yaml:"cascade_evaluator"`
+	PayAnywhereMin int    `yaml:"cascade_pay_anywhere_min_count"`
+
+	MultStart         int    `yaml:"cascade_multiplier_start"`
+	MultGrowth        string `yaml:"cascade_multiplier_growth"`
+	MultStep          int    `yaml:"cascade_multiplier_step"`
+	MultMax           int    `yaml:"cascade_multiplier_max"`
+	MultCombine       string `yaml:"cascade_multiplier_combine"`
+	MultResetEachSpin bool   `yaml:"cascade_multiplier_reset_each_free_spin"`
 }
 
 func NewCascadeConfigFromYAML(path string) (config.CascadeConfig, error) {
@@ -23,9 +59,121 @@ func NewCascadeConfigFromYAML(path string) (config.CascadeConfig, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
 
+// validate проставляет значения по умолчанию и проверяет настройки
+func (cfg *cascadeConfig) validate() error {
+	if cfg.ScatterFeatureVal == "" {
+		cfg.ScatterFeatureVal = config.ScatterFeatureFreeSpins
+	}
+	switch cfg.ScatterFeatureVal {
+	case config.ScatterFeatureFreeSpins, config.ScatterFeatureWheel:
+	default:
+		return fmt.Errorf("unknown cascade scatter feature %q", cfg.ScatterFeatureVal)
+	}
+	// Ключ не задан — фриспины используют вероятность базовой игры; явный 0 выключает бонусы во фриспинах
+	if cfg.BonusPerColumnFS == nil {
+		cfg.BonusPerColumnFS = &cfg.BonusPerColumn
+	}
+	if cfg.BonusMaxColumn == 0 {
+		cfg.BonusMaxColumn = 1
+	}
+	if cfg.BonusPerColumn < 0 || cfg.BonusPerColumn > 1 || *cfg.BonusPerColumnFS < 0 || *cfg.BonusPerColumnFS > 1 {
+		return errors.New("cascade bonus probability must be within [0, 1]")
+	}
+	if cfg.BonusMaxColumn < 0 || cfg.BonusMaxBoard < 0 {
+		return errors.New("cascade bonus limits must not be negative")
+	}
+
+	if cfg.MaxWin == 0 {
+		cfg.MaxWin = defaultMaxWinXBet
+	}
+	if cfg.MaxWin < 0 {
+		return errors.New("cascade max win must be positive")
+	}
+	if cfg.EvaluatorName == "" {
+		cfg.EvaluatorName = config.CascadeEvaluatorCluster
+	}
+	if cfg.PayAnywhereMin == 0 {
+		cfg.PayAnywhereMin = defaultPayAnywhereMinCount
+	}
+	switch cfg.EvaluatorName {
+	case config.CascadeEvaluatorCluster, config.CascadeEvaluatorPayAnywhere:
+	default:
+		return fmt.Errorf("unknown cascade evaluator %q", cfg.EvaluatorName)
+	}
+	if cfg.PayAnywhereMin < 1 {
+		return errors.New("cascade pay anywhere min count must be positive")
+	}
+
+	if cfg.MultStart == 0 {
+		cfg.MultStart = defaultMultiplierStart
+	}
+	if cfg.MultStep == 0 {
+		cfg.MultStep = defaultMultiplierStep
+	}
+	if cfg.MultMax == 0 {
+		cfg.MultMax = defaultMultiplierMax
+	}
+	if cfg.MultGrowth == "" {
+		cfg.MultGrowth = config.MultiplierGrowthDouble
+	}
+	if cfg.MultCombine == "" {
+		cfg.MultCombine = config.MultiplierCombineAverage
+	}
+
+	if len(cfg.BonusBuysData) == 0 {
+		cfg.BonusBuysData = []config.CascadeBonusBuy{{
+			ID:              "standard",
+			Name:            "Bonus",
+			PriceXBet:       defaultBonusBuyPrice,
+			FreeSpins:       defaultBonusBuyFreeSpins,
+			StartMultiplier: 1,
+		}}
+	}
+	ids := map[string]bool{}
+	for i := range cfg.BonusBuysData {
+		buy := &cfg.BonusBuysData[i]
+		if buy.StartMultiplier == 0 {
+			buy.StartMultiplier = 1
+		}
+		if buy.ID == "" || ids[buy.ID] {
+			return fmt.Errorf("cascade bonus buy #%d has empty or duplicate id %q", i, buy.ID)
+		}
+		ids[buy.ID] = true
+		if buy.PriceXBet <= 0 || buy.FreeSpins <= 0 || buy.StartMultiplier < 0 {
+			return fmt.Errorf("cascade bonus buy %q: price and free spins must be positive", buy.ID)
+		}
+	}
+
+	if cfg.MultStart < 1 || cfg.MultStep < 1 {
+		return errors.New("cascade multiplier start and step must be positive")
+	}
+	if cfg.MultMax < cfg.MultStart {
+		return fmt.Errorf("cascade multiplier max (%d) is less than start (%d)", cfg.MultMax, cfg.MultStart)
+	}
+	for _, buy := range cfg.BonusBuysData {
+		if buy.StartMultiplier > cfg.MultMax {
+			return fmt.Errorf("cascade bonus buy %q: start multiplier exceeds max %d", buy.ID, cfg.MultMax)
+		}
+	}
+	switch cfg.MultGrowth {
+	case config.MultiplierGrowthDouble, config.MultiplierGrowthAdd:
+	default:
+		return fmt.Errorf("unknown cascade multiplier growth %q", cfg.MultGrowth)
+	}
+	switch cfg.MultCombine {
+	case config.MultiplierCombineAverage, config.MultiplierCombineSum, config.MultiplierCombineProduct:
+	default:
+		return fmt.Errorf("unknown cascade multiplier combine mode %q", cfg.MultCombine)
+	}
+	return nil
+}
+
 func (cfg *cascadeConfig) SymbolWeights() map[int]int {
 	return cfg.SymbolWeightsData
 }
@@ -34,10 +182,66 @@ func (cfg *cascadeConfig) BonusProbPerColumn() float64 {
 	return cfg.BonusPerColumn
 }
 
+func (cfg *cascadeConfig) BonusProbPerColumnFreeSpins() float64 {
+	return *cfg.BonusPerColumnFS
+}
+
+func (cfg *cascadeConfig) BonusMaxPerColumn() int {
+	return cfg.BonusMaxColumn
+}
+
+func (cfg *cascadeConfig) BonusMaxPerBoard() int {
+	return cfg.BonusMaxBoard
+}
+
 func (cfg *cascadeConfig) BonusAwards() map[int]int {
 	return cfg.BonusAwardsData
 }
 
+func (cfg *cascadeConfig) BonusBuys() []config.CascadeBonusBuy {
+	return cfg.BonusBuysData
+}
+
 func (cfg *cascadeConfig) PayoutTable() map[int]int {
 	return cfg.PayTable
 }
+
+func (cfg *cascadeConfig) MaxWinXBet() int {
+	return cfg.MaxWin
+}
+
+func (cfg *cascadeConfig) Evaluator() string {
+	return cfg.EvaluatorName
+}
+
+func (cfg *cascadeConfig) PayAnywhereMinCount() int {
+	return cfg.PayAnywhereMin
+}
+
+func (cfg *cascadeConfig) MultiplierStart() int {
+	return cfg.MultStart
+}
+
+func (cfg *cascadeConfig) MultiplierGrowth() string {
+	return cfg.MultGrowth
+}
+
+func (cfg *cascadeConfig) MultiplierStep() int {
+	return cfg.MultStep
+}
+
+func (cfg *cascadeConfig) MultiplierMax() int {
+	return cfg.MultMax
+}
+
+func (cfg *cascadeConfig) MultiplierCombine() string {
+	return cfg.MultCombine
+}
+
+func (cfg *cascadeConfig) MultiplierResetEachFreeSpin() bool {
+	return cfg.MultResetEachSpin
+}
+
+func (cfg *cascadeConfig) ScatterFeature() string {
+	return cfg.ScatterFeatureVal
+}
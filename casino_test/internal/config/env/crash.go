@@ -0,0 +1,120 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Значения краш-игры по умолчанию
+const (
+	defaultCrashHouseEdge      = 0.01
+	defaultCrashBettingSeconds = 5
+	defaultCrashPauseSeconds   = 3
+	defaultCrashTickMillis     = 100
+	defaultCrashGrowthRate     = 0.06
+	defaultCrashMaxMultiplier  = 10000
+	defaultCrashMinBet         = 1
+	defaultCrashMaxBet         = 10000
+)
+
+type crashConfig struct {
+	Edge      float64 `yaml:"crash_house_edge"`
+	Betting   int     `yaml:"crash_betting_seconds"`
+	Pause     int     `yaml:"crash_pause_seconds"`
+	Tick      int     `yaml:"crash_tick_ms"`
+	Growth    float64 `yaml:"crash_growth_rate"`
+	MaxMult   float64 `yaml:"crash_max_multiplier"`
+	MinBetVal int     `yaml:"crash_min_bet"`
+	MaxBetVal int     `yaml:"crash_max_bet"`
+}
+
+func NewCrashConfigFromYAML(path string) (config.CrashConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg crashConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проставляет значения по умолчанию и проверяет настройки
+func (cfg *crashConfig) validate() error {
+	if cfg.Edge == 0 {
+		cfg.Edge = defaultCrashHouseEdge
+	}
+	if cfg.Betting == 0 {
+		cfg.Betting = defaultCrashBettingSeconds
+	}
+	if cfg.Pause == 0 {
+		cfg.Pause = defaultCrashPauseSeconds
+	}
+	if cfg.Tick == 0 {
+		cfg.Tick = defaultCrashTickMillis
+	}
+	if cfg.Growth == 0 {
+		cfg.Growth = defaultCrashGrowthRate
+	}
+	if cfg.MaxMult == 0 {
+		cfg.MaxMult = defaultCrashMaxMultiplier
+	}
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultCrashMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultCrashMaxBet
+	}
+	if cfg.Edge < 0 || cfg.Edge >= 1 {
+		return errors.New("crash house edge must be within [0, 1)")
+	}
+	if cfg.Betting < 0 || cfg.Pause < 0 || cfg.Tick < 0 || cfg.Growth < 0 {
+		return errors.New("crash timings and growth rate must be positive")
+	}
+	if cfg.MaxMult <= 1 {
+		return errors.New("crash max multiplier must be greater than 1")
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("crash bet limits are invalid")
+	}
+	return nil
+}
+
+func (cfg *crashConfig) HouseEdge() float64 {
+	return cfg.Edge
+}
+
+func (cfg *crashConfig) BettingSeconds() int {
+	return cfg.Betting
+}
+
+func (cfg *crashConfig) PauseSeconds() int {
+	return cfg.Pause
+}
+
+func (cfg *crashConfig) TickMillis() int {
+	return cfg.Tick
+}
+
+func (cfg *crashConfig) GrowthRate() float64 {
+	return cfg.Growth
+}
+
+func (cfg *crashConfig) MaxMultiplier() float64 {
+	return cfg.MaxMult
+}
+
+func (cfg *crashConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *crashConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
@@ -0,0 +1,71 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+type jackpotConfig struct {
+	TiersData []config.JackpotTier `yaml:"jackpot_tiers"`
+}
+
+func NewJackpotConfigFromYAML(path string) (config.JackpotConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg jackpotConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет уровни джекпота
+func (cfg *jackpotConfig) validate() error {
+	ids := map[string]bool{}
+	var share float64
+	for i, tier := range cfg.TiersData {
+		if tier.ID == "" || ids[tier.ID] {
+			return fmt.Errorf("jackpot tier #%d has empty or duplicate id %q", i, tier.ID)
+		}
+		ids[tier.ID] = true
+		if tier.Contribution < 0 || tier.Seed < 0 {
+			return fmt.Errorf("jackpot %q: contribution and seed must not be negative", tier.ID)
+		}
+		share += tier.Contribution
+
+		switch tier.Trigger {
+		case config.JackpotTriggerMustDrop:
+			if tier.MustDropBy <= tier.Seed {
+				return fmt.Errorf("jackpot %q: must_drop_by must be greater than seed", tier.ID)
+			}
+		case config.JackpotTriggerSymbol:
+			if len(tier.SymbolCounts) == 0 {
+				return fmt.Errorf("jackpot %q: symbol_counts must list at least one game", tier.ID)
+			}
+			for game, count := range tier.SymbolCounts {
+				if count <= 0 {
+					return fmt.Errorf("jackpot %q: symbol count for %q must be positive", tier.ID, game)
+				}
+			}
+		default:
+			return fmt.Errorf("jackpot %q: unknown trigger %q", tier.ID, tier.Trigger)
+		}
+	}
+	if share >= 1 {
+		return errors.New("jackpot contributions must take less than the whole bet")
+	}
+	return nil
+}
+
+func (cfg *jackpotConfig) Tiers() []config.JackpotTier {
+	return cfg.TiersData
+}
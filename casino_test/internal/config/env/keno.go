@@ -0,0 +1,96 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Значения кено по умолчанию
+const (
+	defaultKenoMaxDraws = 20
+	defaultKenoMinBet   = 1
+	defaultKenoMaxBet   = 1000
+)
+
+type kenoConfig struct {
+	Pays      map[int]map[int]float64 `yaml:"keno_paytable"`
+	Draws     int                     `yaml:"keno_max_draws"`
+	MinBetVal int                     `yaml:"keno_min_bet"`
+	MaxBetVal int                     `yaml:"keno_max_bet"`
+}
+
+func NewKenoConfigFromYAML(path string) (config.KenoConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg kenoConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет, что таблица есть для каждого числа отметок 1..10
+// и в ней нет совпадений больше, чем отмечено чисел
+func (cfg *kenoConfig) validate() error {
+	if cfg.Draws == 0 {
+		cfg.Draws = defaultKenoMaxDraws
+	}
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultKenoMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultKenoMaxBet
+	}
+	if cfg.Draws < 1 {
+		return errors.New("keno max draws must be positive")
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("keno bet limits are invalid")
+	}
+
+	for picks := config.KenoMinPicks; picks <= config.KenoMaxPicks; picks++ {
+		pays, ok := cfg.Pays[picks]
+		if !ok {
+			return fmt.Errorf("keno paytable for %d picks is missing", picks)
+		}
+		for hits, mult := range pays {
+			if hits < 0 || hits > picks {
+				return fmt.Errorf("keno paytable for %d picks: invalid hit count %d", picks, hits)
+			}
+			if mult < 0 {
+				return fmt.Errorf("keno paytable for %d picks: multipliers must not be negative", picks)
+			}
+		}
+	}
+	for picks := range cfg.Pays {
+		if picks < config.KenoMinPicks || picks > config.KenoMaxPicks {
+			return fmt.Errorf("keno paytable: invalid pick count %d", picks)
+		}
+	}
+	return nil
+}
+
+func (cfg *kenoConfig) PayTable() map[int]map[int]float64 {
+	return cfg.Pays
+}
+
+func (cfg *kenoConfig) MaxDraws() int {
+	return cfg.Draws
+}
+
+func (cfg *kenoConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *kenoConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
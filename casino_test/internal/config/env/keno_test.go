@@ -0,0 +1,31 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"math/big"
+	"testing"
+)
+
+// Вероятность совпасть hits из picks — гипергеометрическая:
+// C(picks, hits) * C(80 - picks, 20 - hits) / C(80, 20). Таблица из config.yaml обещает 92–95%
+func TestShippedKenoRTP(t *testing.T) {
+	cfg, err := NewKenoConfigFromYAML("../../../config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	binomial := func(n, k int) float64 {
+		v, _ := new(big.Int).Binomial(int64(n), int64(k)).Float64()
+		return v
+	}
+	draws := binomial(config.KenoNumbers, config.KenoDrawn)
+	for picks := config.KenoMinPicks; picks <= config.KenoMaxPicks; picks++ {
+		rtp := 0.0
+		for hits, mult := range cfg.PayTable()[picks] {
+			p := binomial(picks, hits) * binomial(config.KenoNumbers-picks, config.KenoDrawn-hits) / draws
+			rtp += p * mult
+		}
+		if rtp < 0.92 || rtp > 0.955 {
+			t.Errorf("%d picks: RTP %.4f, want 0.92-0.955", picks, rtp)
+		}
+	}
+}
@@ -2,16 +2,57 @@ package env
 
 import (
 	"casino_test/internal/config"
+	"errors"
+	"fmt"
 	"os"
 
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	// Ограничение максимального выигрыша по умолчанию (в кратности ставки)
+	defaultLineMaxWinXBet = 10000
+	// Во сколько раз путь в режиме ways платит меньше линии
+	defaultWaysPayDivisor = 10
+	// Hold and win: от скольких денежных символов стартует и сколько даёт респинов
+	defaultHoldAndWinTrigger = 6
+	defaultHoldAndWinRespins = 3
+	// Игра на удвоение: максимум попыток подряд и потолок выигрыша (x ставки)
+	defaultGambleMaxSteps  = 5
+	defaultGambleLimitXBet = 500
+	// Pick-em: сколько выборов у игрока в начале
+	defaultPickEmPicks = 3
+)
+
 type lineConfig struct {
 	SymbolWeightsData map[string]int         `yaml:"line_symbol_weights"`
 	WildChanceValue   float64                `yaml:"line_wild_chance_on_reel_2_3_4"`
 	FreeSpinsScatter  map[int]int            `yaml:"line_free_spins_by_scatter"`
 	PayTable          map[string]map[int]int `yaml:"line_payout_table"`
+	WildPayTable      map[int]int            `yaml:"line_wild_payout_table"`
+	PaylinesData      [][]int                `yaml:"line_paylines"`
+	StickyWilds       bool                   `yaml:"line_free_spin_sticky_wilds"`
+	WildMultipliers   map[int]int            `yaml:"line_free_spin_wild_multipliers"`
+	MultiplierStep    int                    `yaml:"line_free_spin_multiplier_step"`
+	HoldTrigger       int                    `yaml:"line_hold_and_win_trigger"`
+	HoldRespins       int                    `yaml:"line_hold_and_win_respins"`
+	HoldLandChance    float64                `yaml:"line_hold_and_win_land_chance"`
+	MoneyValueWeights map[int]int            `yaml:"line_money_values"`
+	JackpotWeights    map[string]int         `yaml:"line_money_jackpot_weights"`
+	Jackpots          map[string]int         `yaml:"line_money_jackpots"`
+	ScatterFeatureVal string                 `yaml:"line_scatter_feature"`
+	PickEmPicksVal    int                    `yaml:"line_pick_em_picks"`
+	PickEmPrizesData  []config.PickEmPrize   `yaml:"line_pick_em_prizes"`
+	Gamble            bool                   `yaml:"line_gamble_enabled"`
+	GambleSteps       int                    `yaml:"line_gamble_max_steps"`
+	GambleLimit       int                    `yaml:"line_gamble_limit_x_bet"`
+	Tumble            bool                   `yaml:"line_tumble_enabled"`
+	TumbleStep        int                    `yaml:"line_tumble_multiplier_step"`
+	EvaluatorName     string                 `yaml:"line_evaluator"`
+	WaysDivisor       int                    `yaml:"line_ways_pay_divisor"`
+	Direction         string                 `yaml:"line_pay_direction"`
+	FullLine          string                 `yaml:"line_both_ways_full_line"`
+	MaxWin            int                    `yaml:"line_max_win_x_bet"`
 }
 
 func NewLineConfigFromYAML(path string) (config.LineConfig, error) {
@@ -23,9 +64,112 @@ func NewLineConfigFromYAML(path string) (config.LineConfig, error) {
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
 
+// validate проставляет значения по умолчанию и проверяет настройки
+func (cfg *lineConfig) validate() error {
+	if cfg.MaxWin == 0 {
+		cfg.MaxWin = defaultLineMaxWinXBet
+	}
+	if cfg.MaxWin < 0 {
+		return errors.New("line max win must be positive")
+	}
+	if cfg.MultiplierStep < 0 || cfg.TumbleStep < 0 {
+		return errors.New("line multiplier steps must not be negative")
+	}
+	for m, w := range cfg.WildMultipliers {
+		if m < 1 || w < 0 {
+			return fmt.Errorf("invalid wild multiplier %d with weight %d", m, w)
+		}
+	}
+	if cfg.HoldTrigger == 0 {
+		cfg.HoldTrigger = defaultHoldAndWinTrigger
+	}
+	if cfg.HoldRespins == 0 {
+		cfg.HoldRespins = defaultHoldAndWinRespins
+	}
+	if cfg.HoldTrigger < 1 || cfg.HoldRespins < 1 {
+		return errors.New("hold and win trigger and respins must be positive")
+	}
+	if cfg.HoldLandChance < 0 || cfg.HoldLandChance > 1 {
+		return errors.New("hold and win land chance must be within [0, 1]")
+	}
+	for name := range cfg.JackpotWeights {
+		if _, ok := cfg.Jackpots[name]; !ok {
+			return fmt.Errorf("money jackpot %q has weight but no prize", name)
+		}
+	}
+	if cfg.SymbolWeightsData["M"] > 0 && len(cfg.MoneyValueWeights) == 0 {
+		return errors.New("money symbol is enabled but line_money_values is empty")
+	}
+	// Вайлд-барабаны выпадают только на 2–4, а линия вайлдов начинается с крайнего барабана
+	if len(cfg.WildPayTable) > 0 && cfg.SymbolWeightsData["W"] <= 0 {
+		return errors.New("line wild payout table needs a W symbol weight to land wilds on the edge reels")
+	}
+	if err := cfg.validateScatterFeature(); err != nil {
+		return err
+	}
+	if cfg.GambleSteps == 0 {
+		cfg.GambleSteps = defaultGambleMaxSteps
+	}
+	if cfg.GambleLimit == 0 {
+		cfg.GambleLimit = defaultGambleLimitXBet
+	}
+	if cfg.GambleSteps < 0 || cfg.GambleLimit < 0 {
+		return errors.New("gamble steps and limit must be positive")
+	}
+	if cfg.EvaluatorName == "" {
+		cfg.EvaluatorName = config.LineEvaluatorLines
+	}
+	if cfg.WaysDivisor == 0 {
+		cfg.WaysDivisor = defaultWaysPayDivisor
+	}
+	switch cfg.EvaluatorName {
+	case config.LineEvaluatorLines, config.LineEvaluatorWays:
+	default:
+		return fmt.Errorf("unknown line evaluator %q", cfg.EvaluatorName)
+	}
+	if cfg.Direction == "" {
+		cfg.Direction = config.PayDirectionLeftToRight
+	}
+	if cfg.FullLine == "" {
+		cfg.FullLine = config.FullLinePayOnce
+	}
+	switch cfg.Direction {
+	case config.PayDirectionLeftToRight, config.PayDirectionRightToLeft, config.PayDirectionBoth:
+	default:
+		return fmt.Errorf("unknown line pay direction %q", cfg.Direction)
+	}
+	switch cfg.FullLine {
+	case config.FullLinePayOnce, config.FullLinePayTwice:
+	default:
+		return fmt.Errorf("unknown both ways full line mode %q", cfg.FullLine)
+	}
+	if cfg.WaysDivisor < 0 {
+		return errors.New("line ways pay divisor must be positive")
+	}
+
+	// Линии должны помещаться на поле: по одной строке на каждый барабан
+	if len(cfg.PaylinesData) == 0 {
+		return errors.New("line paylines are not configured")
+	}
+	for i, line := range cfg.PaylinesData {
+		if len(line) != config.LineReels {
+			return fmt.Errorf("payline %d: expected %d positions, got %d", i+1, config.LineReels, len(line))
+		}
+		for reel, row := range line {
+			if row < 0 || row >= config.LineRows {
+				return fmt.Errorf("payline %d: row %d on reel %d is out of board", i+1, row, reel+1)
+			}
+		}
+	}
+	return nil
+}
+
 func (cfg *lineConfig) SymbolWeights() map[string]int {
 	return cfg.SymbolWeightsData
 }
@@ -41,3 +185,135 @@ func (cfg *lineConfig) FreeSpinsByScatter() map[int]int {
 func (cfg *lineConfig) PayoutTable() map[string]map[int]int {
 	return cfg.PayTable
 }
+
+func (cfg *lineConfig) WildPayoutTable() map[int]int {
+	return cfg.WildPayTable
+}
+
+func (cfg *lineConfig) Paylines() [][]int {
+	return cfg.PaylinesData
+}
+
+func (cfg *lineConfig) FreeSpinStickyWilds() bool {
+	return cfg.StickyWilds
+}
+
+func (cfg *lineConfig) FreeSpinWildMultipliers() map[int]int {
+	return cfg.WildMultipliers
+}
+
+func (cfg *lineConfig) FreeSpinMultiplierStep() int {
+	return cfg.MultiplierStep
+}
+
+func (cfg *lineConfig) HoldAndWinTrigger() int {
+	return cfg.HoldTrigger
+}
+
+func (cfg *lineConfig) HoldAndWinRespins() int {
+	return cfg.HoldRespins
+}
+
+func (cfg *lineConfig) HoldAndWinLandChance() float64 {
+	return cfg.HoldLandChance
+}
+
+func (cfg *lineConfig) MoneyValues() map[int]int {
+	return cfg.MoneyValueWeights
+}
+
+func (cfg *lineConfig) MoneyJackpotWeights() map[string]int {
+	return cfg.JackpotWeights
+}
+
+func (cfg *lineConfig) MoneyJackpots() map[string]int {
+	return cfg.Jackpots
+}
+
+// validateScatterFeature проверяет, что запускают скаттеры, и настройки pick-em
+func (cfg *lineConfig) validateScatterFeature() error {
+	if cfg.ScatterFeatureVal == "" {
+		cfg.ScatterFeatureVal = config.ScatterFeatureFreeSpins
+	}
+	switch cfg.ScatterFeatureVal {
+	case config.ScatterFeatureFreeSpins, config.ScatterFeatureWheel:
+		return nil
+	case config.ScatterFeaturePickEm:
+	default:
+		return fmt.Errorf("unknown line scatter feature %q", cfg.ScatterFeatureVal)
+	}
+
+	if cfg.PickEmPicksVal == 0 {
+		cfg.PickEmPicksVal = defaultPickEmPicks
+	}
+	if cfg.PickEmPicksVal < 0 {
+		return errors.New("pick-em picks must be positive")
+	}
+	if len(cfg.PickEmPrizesData) < cfg.PickEmPicksVal {
+		return fmt.Errorf("pick-em needs at least %d prizes", cfg.PickEmPicksVal)
+	}
+	for i, prize := range cfg.PickEmPrizesData {
+		switch prize.Type {
+		case config.PickEmPrizeCredit, config.PickEmPrizeMultiplier, config.PickEmPrizeExtraPick:
+			if prize.Value <= 0 {
+				return fmt.Errorf("pick-em prize #%d (%s) must have a positive value", i, prize.Type)
+			}
+		case config.PickEmPrizeCollect:
+		default:
+			return fmt.Errorf("unknown pick-em prize type %q", prize.Type)
+		}
+	}
+	return nil
+}
+
+func (cfg *lineConfig) ScatterFeature() string {
+	return cfg.ScatterFeatureVal
+}
+
+func (cfg *lineConfig) PickEmPicks() int {
+	return cfg.PickEmPicksVal
+}
+
+func (cfg *lineConfig) PickEmPrizes() []config.PickEmPrize {
+	return cfg.PickEmPrizesData
+}
+
+func (cfg *lineConfig) GambleEnabled() bool {
+	return cfg.Gamble
+}
+
+func (cfg *lineConfig) GambleMaxSteps() int {
+	return cfg.GambleSteps
+}
+
+func (cfg *lineConfig) GambleLimitXBet() int {
+	return cfg.GambleLimit
+}
+
+func (cfg *lineConfig) TumbleEnabled() bool {
+	return cfg.Tumble
+}
+
+func (cfg *lineConfig) TumbleMultiplierStep() int {
+	return cfg.TumbleStep
+}
+
+func (cfg *lineConfig) Evaluator() string {
+	return cfg.EvaluatorName
+}
+
+func (cfg *lineConfig) WaysPayDivisor() int {
+	return cfg.WaysDivisor
+}
+
+func (cfg *lineConfig) PayDirection() string {
+	return cfg.Direction
+}
+
+func (cfg *lineConfig) BothWaysFullLine() string {
+	return cfg.FullLine
+}
+
+func (cfg *lineConfig) MaxWinXBet() int {
+	return cfg.MaxWin
+}
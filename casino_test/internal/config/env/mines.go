@@ -0,0 +1,91 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Значения Mines по умолчанию
+const (
+	defaultMinesHouseEdge = 0.01
+	defaultMinesMinMines  = 1
+	defaultMinesMaxMines  = config.MinesTiles - 1
+	defaultMinesMinBet    = 1
+	defaultMinesMaxBet    = 10000
+)
+
+type minesConfig struct {
+	Edge      float64 `yaml:"mines_house_edge"`
+	MinCount  int     `yaml:"mines_min_mines"`
+	MaxCount  int     `yaml:"mines_max_mines"`
+	MinBetVal int     `yaml:"mines_min_bet"`
+	MaxBetVal int     `yaml:"mines_max_bet"`
+}
+
+func NewMinesConfigFromYAML(path string) (config.MinesConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg minesConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проставляет значения по умолчанию и проверяет настройки
+func (cfg *minesConfig) validate() error {
+	if cfg.Edge == 0 {
+		cfg.Edge = defaultMinesHouseEdge
+	}
+	if cfg.MinCount == 0 {
+		cfg.MinCount = defaultMinesMinMines
+	}
+	if cfg.MaxCount == 0 {
+		cfg.MaxCount = defaultMinesMaxMines
+	}
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultMinesMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultMinesMaxBet
+	}
+	if cfg.Edge < 0 || cfg.Edge >= 1 {
+		return errors.New("mines house edge must be within [0, 1)")
+	}
+	// Хотя бы одна мина и хотя бы одна безопасная клетка
+	if cfg.MinCount < 1 || cfg.MaxCount > config.MinesTiles-1 || cfg.MaxCount < cfg.MinCount {
+		return errors.New("mines count limits must be within [1, 24]")
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("mines bet limits are invalid")
+	}
+	return nil
+}
+
+func (cfg *minesConfig) HouseEdge() float64 {
+	return cfg.Edge
+}
+
+func (cfg *minesConfig) MinMines() int {
+	return cfg.MinCount
+}
+
+func (cfg *minesConfig) MaxMines() int {
+	return cfg.MaxCount
+}
+
+func (cfg *minesConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *minesConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
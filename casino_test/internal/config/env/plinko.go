@@ -0,0 +1,86 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Лимиты ставки плинко по умолчанию
+const (
+	defaultPlinkoMinBet = 1
+	defaultPlinkoMaxBet = 10000
+)
+
+type plinkoConfig struct {
+	// Риск -> число рядов -> множители лунок
+	Payouts   map[string]map[int][]float64 `yaml:"plinko_payouts"`
+	MinBetVal int                          `yaml:"plinko_min_bet"`
+	MaxBetVal int                          `yaml:"plinko_max_bet"`
+}
+
+func NewPlinkoConfigFromYAML(path string) (config.PlinkoConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg plinkoConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет, что для каждого риска есть таблицы на все ряды 8–16 и в каждой ровно rows+1 лунка
+func (cfg *plinkoConfig) validate() error {
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultPlinkoMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultPlinkoMaxBet
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("plinko bet limits are invalid")
+	}
+
+	for _, risk := range []string{config.PlinkoRiskLow, config.PlinkoRiskMedium, config.PlinkoRiskHigh} {
+		tables, ok := cfg.Payouts[risk]
+		if !ok {
+			return fmt.Errorf("plinko payouts for %s risk are missing", risk)
+		}
+		for rows := config.PlinkoMinRows; rows <= config.PlinkoMaxRows; rows++ {
+			mults, ok := tables[rows]
+			if !ok {
+				return fmt.Errorf("plinko %s risk: payouts for %d rows are missing", risk, rows)
+			}
+			if len(mults) != rows+1 {
+				return fmt.Errorf("plinko %s risk, %d rows: expected %d multipliers, got %d", risk, rows, rows+1, len(mults))
+			}
+			for _, m := range mults {
+				if m < 0 {
+					return fmt.Errorf("plinko %s risk, %d rows: multipliers must not be negative", risk, rows)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (cfg *plinkoConfig) Multipliers(risk string, rows int) ([]float64, bool) {
+	mults, ok := cfg.Payouts[risk][rows]
+	return mults, ok
+}
+
+func (cfg *plinkoConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *plinkoConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
@@ -0,0 +1,29 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"math/big"
+	"testing"
+)
+
+// Шарик уходит вправо с вероятностью 1/2, так что в лунку k из rows рядов
+// он попадает с вероятностью C(rows, k) / 2^rows. Таблицы из config.yaml должны возвращать около 99%
+func TestShippedPlinkoRTP(t *testing.T) {
+	cfg, err := NewPlinkoConfigFromYAML("../../../config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, risk := range []string{config.PlinkoRiskLow, config.PlinkoRiskMedium, config.PlinkoRiskHigh} {
+		for rows := config.PlinkoMinRows; rows <= config.PlinkoMaxRows; rows++ {
+			mults, _ := cfg.Multipliers(risk, rows)
+			rtp := 0.0
+			for k, mult := range mults {
+				ways, _ := new(big.Int).Binomial(int64(rows), int64(k)).Float64()
+				rtp += ways / float64(uint(1)<<rows) * mult
+			}
+			if rtp < 0.98 || rtp >= 1 {
+				t.Errorf("%s risk, %d rows: RTP %.4f, want 0.98-1", risk, rows, rtp)
+			}
+		}
+	}
+}
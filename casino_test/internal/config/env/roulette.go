@@ -0,0 +1,87 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Лимиты стола по умолчанию
+const (
+	defaultRouletteMinBet         = 1
+	defaultRouletteMaxInsideBet   = 1000
+	defaultRouletteMaxOutsideBet  = 5000
+	defaultRouletteMaxTotalBet    = 20000
+	defaultRouletteMaxBetsPerSpin = 50
+)
+
+type rouletteConfig struct {
+	Min        int `yaml:"roulette_min_bet"`
+	MaxInside  int `yaml:"roulette_max_inside_bet"`
+	MaxOutside int `yaml:"roulette_max_outside_bet"`
+	MaxTotal   int `yaml:"roulette_max_total_bet"`
+	MaxBets    int `yaml:"roulette_max_bets_per_spin"`
+}
+
+func NewRouletteConfigFromYAML(path string) (config.RouletteConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg rouletteConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проставляет лимиты по умолчанию и проверяет, что они согласованы
+func (cfg *rouletteConfig) validate() error {
+	if cfg.Min == 0 {
+		cfg.Min = defaultRouletteMinBet
+	}
+	if cfg.MaxInside == 0 {
+		cfg.MaxInside = defaultRouletteMaxInsideBet
+	}
+	if cfg.MaxOutside == 0 {
+		cfg.MaxOutside = defaultRouletteMaxOutsideBet
+	}
+	if cfg.MaxTotal == 0 {
+		cfg.MaxTotal = defaultRouletteMaxTotalBet
+	}
+	if cfg.MaxBets == 0 {
+		cfg.MaxBets = defaultRouletteMaxBetsPerSpin
+	}
+	if cfg.Min < 0 || cfg.MaxBets < 0 {
+		return errors.New("roulette min bet and max bets per spin must be positive")
+	}
+	if cfg.MaxInside < cfg.Min || cfg.MaxOutside < cfg.Min || cfg.MaxTotal < cfg.Min {
+		return errors.New("roulette max bets must not be less than the min bet")
+	}
+	return nil
+}
+
+func (cfg *rouletteConfig) MinBet() int {
+	return cfg.Min
+}
+
+func (cfg *rouletteConfig) MaxInsideBet() int {
+	return cfg.MaxInside
+}
+
+func (cfg *rouletteConfig) MaxOutsideBet() int {
+	return cfg.MaxOutside
+}
+
+func (cfg *rouletteConfig) MaxTotalBet() int {
+	return cfg.MaxTotal
+}
+
+func (cfg *rouletteConfig) MaxBetsPerSpin() int {
+	return cfg.MaxBets
+}
@@ -0,0 +1,60 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Источники фронтенда по умолчанию: dev-сервер и preview Vite
+var defaultServerAllowedOrigins = []string{
+	"http://localhost:5173",
+	"http://127.0.0.1:5173",
+	"http://localhost:4173",
+	"http://127.0.0.1:4173",
+}
+
+type serverConfig struct {
+	Origins []string `yaml:"server_allowed_origins"`
+}
+
+func NewServerConfigFromYAML(path string) (config.ServerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg serverConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проставляет источники по умолчанию и проверяет, что каждый — конкретный http(s) origin
+func (cfg *serverConfig) validate() error {
+	if len(cfg.Origins) == 0 {
+		cfg.Origins = defaultServerAllowedOrigins
+	}
+	for _, origin := range cfg.Origins {
+		if !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			return fmt.Errorf("allowed origin %q must start with http:// or https://", origin)
+		}
+		if strings.Contains(origin, "*") {
+			return fmt.Errorf("allowed origin %q must not contain wildcards", origin)
+		}
+		if strings.HasSuffix(origin, "/") {
+			return fmt.Errorf("allowed origin %q must not end with a slash", origin)
+		}
+	}
+	return nil
+}
+
+func (cfg *serverConfig) AllowedOrigins() []string {
+	return cfg.Origins
+}
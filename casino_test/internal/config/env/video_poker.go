@@ -0,0 +1,82 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Лимиты ставки видеопокера по умолчанию
+const (
+	defaultVideoPokerMinBet = 1
+	defaultVideoPokerMaxBet = 1000
+)
+
+var pokerHands = []string{
+	config.PokerRoyalFlush, config.PokerStraightFlush, config.PokerFourOfAKind,
+	config.PokerFullHouse, config.PokerFlush, config.PokerStraight,
+	config.PokerThreeOfAKind, config.PokerTwoPair, config.PokerJacksOrBetter,
+}
+
+type videoPokerConfig struct {
+	Pays      map[string]int `yaml:"video_poker_paytable"`
+	MinBetVal int            `yaml:"video_poker_min_bet"`
+	MaxBetVal int            `yaml:"video_poker_max_bet"`
+}
+
+func NewVideoPokerConfigFromYAML(path string) (config.VideoPokerConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg videoPokerConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет, что в таблице выплат только известные комбинации и выплаты не отрицательные.
+// Комбинация, которой нет в таблице, не платит.
+func (cfg *videoPokerConfig) validate() error {
+	if cfg.MinBetVal == 0 {
+		cfg.MinBetVal = defaultVideoPokerMinBet
+	}
+	if cfg.MaxBetVal == 0 {
+		cfg.MaxBetVal = defaultVideoPokerMaxBet
+	}
+	if cfg.MinBetVal < 0 || cfg.MaxBetVal < cfg.MinBetVal {
+		return errors.New("video poker bet limits are invalid")
+	}
+	if len(cfg.Pays) == 0 {
+		return errors.New("video poker paytable is empty")
+	}
+	for hand, pay := range cfg.Pays {
+		if !slices.Contains(pokerHands, hand) {
+			return fmt.Errorf("video poker paytable: unknown hand %q", hand)
+		}
+		if pay < 0 {
+			return fmt.Errorf("video poker paytable: %s pay must not be negative", hand)
+		}
+	}
+	return nil
+}
+
+func (cfg *videoPokerConfig) PayTable() map[string]int {
+	return cfg.Pays
+}
+
+func (cfg *videoPokerConfig) MinBet() int {
+	return cfg.MinBetVal
+}
+
+func (cfg *videoPokerConfig) MaxBet() int {
+	return cfg.MaxBetVal
+}
@@ -0,0 +1,96 @@
+package env
+
+import (
+	"casino_test/internal/config"
+	"errors"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+type wheelConfig struct {
+	Start  string         `yaml:"wheel_start"`
+	Wheels []config.Wheel `yaml:"wheel_list"`
+
+	byID map[string]config.Wheel
+}
+
+func NewWheelConfigFromYAML(path string) (config.WheelConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg wheelConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// validate проверяет колёса: сектора, переходы level_up и то, что бонус всегда может закончиться
+func (cfg *wheelConfig) validate() error {
+	if len(cfg.Wheels) == 0 {
+		return errors.New("wheel_list is empty")
+	}
+
+	cfg.byID = make(map[string]config.Wheel, len(cfg.Wheels))
+	for i, wheel := range cfg.Wheels {
+		if wheel.ID == "" {
+			return fmt.Errorf("wheel #%d has empty id", i)
+		}
+		if _, ok := cfg.byID[wheel.ID]; ok {
+			return fmt.Errorf("duplicate wheel id %q", wheel.ID)
+		}
+		cfg.byID[wheel.ID] = wheel
+	}
+	if cfg.Start == "" {
+		cfg.Start = cfg.Wheels[0].ID
+	}
+	if _, ok := cfg.byID[cfg.Start]; !ok {
+		return fmt.Errorf("unknown start wheel %q", cfg.Start)
+	}
+
+	for _, wheel := range cfg.Wheels {
+		// Без сектора с выигрышем или фриспинами колесо могло бы крутиться бесконечно
+		final := false
+		for i, seg := range wheel.Segments {
+			if seg.Weight < 0 {
+				return fmt.Errorf("wheel %q segment #%d has negative weight", wheel.ID, i)
+			}
+			switch seg.Type {
+			case config.WheelSegmentCredit, config.WheelSegmentFreeSpins:
+				if seg.Value <= 0 {
+					return fmt.Errorf("wheel %q segment #%d must have a positive value", wheel.ID, i)
+				}
+				final = final || seg.Weight > 0
+			case config.WheelSegmentMultiplier:
+				if seg.Value < 1 {
+					return fmt.Errorf("wheel %q segment #%d must have a multiplier of at least 1", wheel.ID, i)
+				}
+			case config.WheelSegmentLevelUp:
+				if _, ok := cfg.byID[wheel.Next]; !ok {
+					return fmt.Errorf("wheel %q has a level_up segment but no valid next wheel", wheel.ID)
+				}
+			default:
+				return fmt.Errorf("unknown wheel segment type %q", seg.Type)
+			}
+		}
+		if !final {
+			return fmt.Errorf("wheel %q needs a credit or free_spins segment with positive weight", wheel.ID)
+		}
+	}
+	return nil
+}
+
+func (cfg *wheelConfig) StartWheel() string {
+	return cfg.Start
+}
+
+func (cfg *wheelConfig) Wheel(id string) (config.Wheel, bool) {
+	wheel, ok := cfg.byID[id]
+	return wheel, ok
+}
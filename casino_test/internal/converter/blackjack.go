@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToBlackjackTableResponse(table model.BlackjackTable) dto.BlackjackTableResponse {
+	res := dto.BlackjackTableResponse{
+		DealerValue: table.DealerValue,
+		Actions:     make([]string, len(table.Actions)),
+		ShoeLeft:    table.ShoeLeft,
+		Balance:     table.Balance,
+	}
+	copy(res.Actions, table.Actions)
+	if table.Round == nil {
+		return res
+	}
+
+	round := table.Round
+	hands := make([]dto.BlackjackHand, len(round.Hands))
+	for i, hand := range round.Hands {
+		hands[i] = dto.BlackjackHand{
+			Cards:   ToCards(hand.Cards),
+			Bet:     hand.Bet,
+			Doubled: hand.Doubled,
+			Split:   hand.Split,
+			Done:    hand.Done,
+			Value:   hand.Value,
+			Soft:    hand.Soft,
+			Result:  hand.Result,
+			Payout:  hand.Payout,
+		}
+	}
+	res.Round = &dto.BlackjackRound{
+		Phase:           round.Phase,
+		Bet:             round.Bet,
+		Hands:           hands,
+		Current:         round.Current,
+		Dealer:          ToCards(round.Dealer),
+		Insurance:       round.Insurance,
+		InsurancePayout: round.InsurancePayout,
+		TotalPayout:     round.TotalPayout,
+	}
+	return res
+}
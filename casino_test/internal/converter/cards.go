@@ -0,0 +1,30 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+	"strconv"
+)
+
+var rankNames = map[int]string{
+	model.RankJack:  "J",
+	model.RankQueen: "Q",
+	model.RankKing:  "K",
+	model.RankAce:   "A",
+}
+
+func ToCard(card model.Card) dto.Card {
+	name, ok := rankNames[card.Rank]
+	if !ok {
+		name = strconv.Itoa(card.Rank)
+	}
+	return dto.Card{Rank: name, Suit: card.Suit}
+}
+
+func ToCards(cards []model.Card) []dto.Card {
+	res := make([]dto.Card, len(cards))
+	for i, card := range cards {
+		res[i] = ToCard(card)
+	}
+	return res
+}
@@ -23,6 +23,9 @@ func ToCascadeSpinResponse(resp model.CascadeSpinResult) dto.CascadeSpinResponse
 		AwardedFreeSpins: resp.AwardedFreeSpins,
 		FreeSpinsLeft:    resp.FreeSpinsLeft,
 		InFreeSpin:       resp.InFreeSpin,
+		MaxWinReached:    resp.MaxWinReached,
+		Wheel:            toWheel(resp.Wheel),
+		Jackpots:         toJackpotWins(resp.Jackpots),
 	}
 }
 
@@ -81,10 +84,46 @@ func toNewSymbols(newSyms []struct {
 	return result
 }
 
+func ToCascadeBuyBonus(req dto.BuyCascadeBonusRequest) model.CascadeBuyBonus {
+	return model.CascadeBuyBonus{
+		ProductID: req.ProductID,
+		Bet:       req.Bet,
+	}
+}
+
+func ToBuyBonusResponse(res model.CascadeBuyBonusResult) dto.BuyBonusResponse {
+	return dto.BuyBonusResponse{
+		Success:       true,
+		ProductID:     res.ProductID,
+		AwardedSpins:  res.AwardedSpins,
+		Cost:          res.Cost,
+		Balance:       res.Balance,
+		FreeSpinsLeft: res.FreeSpinsLeft,
+	}
+}
+
+func ToCascadeGameInfoResponse(info model.CascadeGameInfo) dto.CascadeGameInfoResponse {
+	products := make([]dto.BonusBuyProduct, len(info.BonusBuys))
+	for i, p := range info.BonusBuys {
+		products[i] = dto.BonusBuyProduct{
+			ID:              p.ID,
+			Name:            p.Name,
+			PriceXBet:       p.PriceXBet,
+			FreeSpins:       p.FreeSpins,
+			StartMultiplier: p.StartMultiplier,
+		}
+	}
+	return dto.CascadeGameInfoResponse{
+		BonusBuys:  products,
+		MaxWinXBet: info.MaxWinXBet,
+	}
+}
+
 // Общий ответ с балансом и фриспинами
 func ToCascadeDataResponse(data model.CascadeData) dto.CascadeDataResponse {
 	return dto.CascadeDataResponse{
 		Balance:       data.Balance,
 		FreeSpinsLeft: data.FreeSpinCount,
+		Wheel:         toWheel(data.Wheel),
 	}
 }
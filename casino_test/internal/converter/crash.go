@@ -0,0 +1,79 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+	"time"
+)
+
+func ToCrashBet(req dto.CrashBetRequest) model.CrashBet {
+	return model.CrashBet{
+		PlayerID:    req.PlayerID,
+		Amount:      req.Amount,
+		AutoCashout: req.AutoCashout,
+	}
+}
+
+func ToCrashBetResponse(bet model.CrashBet) dto.CrashBet {
+	return dto.CrashBet{
+		PlayerID:    bet.PlayerID,
+		Amount:      bet.Amount,
+		AutoCashout: bet.AutoCashout,
+		CashedOut:   bet.CashedOut,
+		CashoutAt:   bet.CashoutAt,
+		Payout:      bet.Payout,
+	}
+}
+
+func ToCrashPlacedBetResponse(bet model.CrashBet) dto.CrashPlacedBet {
+	return dto.CrashPlacedBet{CrashBet: ToCrashBetResponse(bet), Token: bet.Token}
+}
+
+func ToCrashEvent(event model.CrashEvent) dto.CrashEvent {
+	result := dto.CrashEvent{
+		Type:  event.Type,
+		Round: toCrashRound(event.Round),
+	}
+	if event.Bet != nil {
+		bet := ToCrashBetResponse(*event.Bet)
+		result.Bet = &bet
+	}
+	return result
+}
+
+func ToCrashStateResponse(state model.CrashState) dto.CrashStateResponse {
+	bets := make([]dto.CrashBet, len(state.Bets))
+	for i, bet := range state.Bets {
+		bets[i] = ToCrashBetResponse(bet)
+	}
+	history := make([]dto.CrashRound, len(state.History))
+	for i, round := range state.History {
+		history[i] = toCrashRound(round)
+	}
+	return dto.CrashStateResponse{
+		Round:   toCrashRound(state.Round),
+		Bets:    bets,
+		History: history,
+	}
+}
+
+func toCrashRound(round model.CrashRound) dto.CrashRound {
+	return dto.CrashRound{
+		ID:          round.ID,
+		Phase:       round.Phase,
+		Hash:        round.Hash,
+		Seed:        round.Seed,
+		CrashPoint:  round.CrashPoint,
+		Multiplier:  round.Multiplier,
+		BetsUntilMs: unixMillis(round.BetsUntil),
+		StartedAtMs: unixMillis(round.StartedAt),
+	}
+}
+
+// unixMillis время в миллисекундах Unix (0 для нулевого времени)
+func unixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
@@ -0,0 +1,21 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToGamesResponse(metas []model.GameMeta) dto.GamesResponse {
+	games := make([]dto.GameMeta, len(metas))
+	for i, meta := range metas {
+		games[i] = dto.GameMeta{
+			ID:          meta.ID,
+			Name:        meta.Name,
+			Category:    meta.Category,
+			Description: meta.Description,
+			Path:        meta.Path,
+			LegacyPath:  meta.LegacyPath,
+		}
+	}
+	return dto.GamesResponse{Games: games}
+}
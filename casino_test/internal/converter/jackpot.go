@@ -0,0 +1,28 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToJackpotsResponse(pools []model.JackpotPool) dto.JackpotsResponse {
+	result := make([]dto.JackpotPool, len(pools))
+	for i, pool := range pools {
+		result[i] = dto.JackpotPool{
+			ID:    pool.ID,
+			Value: int(pool.Value),
+		}
+	}
+	return dto.JackpotsResponse{Pools: result}
+}
+
+func toJackpotWins(wins []model.JackpotWin) []dto.JackpotWin {
+	result := make([]dto.JackpotWin, len(wins))
+	for i, win := range wins {
+		result[i] = dto.JackpotWin{
+			ID:     win.ID,
+			Amount: win.Amount,
+		}
+	}
+	return result
+}
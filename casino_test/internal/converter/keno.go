@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToKenoPurchase(req dto.KenoTicketRequest) model.KenoPurchase {
+	return model.KenoPurchase{
+		Picks: req.Picks,
+		Bet:   req.Bet,
+		Draws: req.Draws,
+	}
+}
+
+func ToKenoTicket(ticket model.KenoTicket) dto.KenoTicket {
+	results := make([]dto.KenoDraw, len(ticket.Results))
+	for i, draw := range ticket.Results {
+		hits := make([]int, len(draw.Hits))
+		copy(hits, draw.Hits)
+		results[i] = dto.KenoDraw{
+			DrawID:     draw.DrawID,
+			Numbers:    draw.Numbers,
+			Hits:       hits,
+			Multiplier: draw.Multiplier,
+			Payout:     draw.Payout,
+		}
+	}
+	return dto.KenoTicket{
+		ID:          ticket.ID,
+		Picks:       ticket.Picks,
+		Bet:         ticket.Bet,
+		Draws:       ticket.Draws,
+		TotalBet:    ticket.TotalBet,
+		TotalPayout: ticket.TotalPayout,
+		Results:     results,
+	}
+}
+
+func ToKenoTicketResponse(res model.KenoTicketResult) dto.KenoTicketResponse {
+	return dto.KenoTicketResponse{
+		Ticket:  ToKenoTicket(res.Ticket),
+		Balance: res.Balance,
+	}
+}
+
+func ToKenoTicketsResponse(tickets []model.KenoTicket) dto.KenoTicketsResponse {
+	res := dto.KenoTicketsResponse{Tickets: make([]dto.KenoTicket, len(tickets))}
+	for i, ticket := range tickets {
+		res.Tickets[i] = ToKenoTicket(ticket)
+	}
+	return res
+}
+
+func ToKenoGameInfoResponse(info model.KenoGameInfo) dto.KenoGameInfoResponse {
+	return dto.KenoGameInfoResponse{
+		Numbers:  info.Numbers,
+		Drawn:    info.Drawn,
+		MinPicks: info.MinPicks,
+		MaxPicks: info.MaxPicks,
+		MaxDraws: info.MaxDraws,
+		MinBet:   info.MinBet,
+		MaxBet:   info.MaxBet,
+		PayTable: info.PayTable,
+	}
+}
@@ -2,26 +2,42 @@ package converter
 
 import (
 	"casino_test/internal/api/dto"
+	"casino_test/internal/config"
 	"casino_test/internal/model"
 )
 
 func ToLineSpin(req dto.LineSpinRequest) model.LineSpin {
 	return model.LineSpin{
-		Bet: req.Bet,
+		Bet:   req.Bet,
+		Lines: req.Lines,
 	}
 }
 
 func ToLineSpinResponse(resp model.SpinResult) dto.LineSpinResponse {
 	return dto.LineSpinResponse{
+		InitialBoard:     resp.InitialBoard,
 		Board:            resp.Board,
+		Tumbles:          toTumbleSteps(resp.Tumbles),
+		WildReels:        resp.WildReels,
+		WildMultipliers:  resp.WildMultipliers,
+		SpinMultiplier:   resp.SpinMultiplier,
 		LineWins:         toLineWins(resp.LineWins),
 		ScatterCount:     resp.ScatterCount,
+		ScatterPositions: toLinePositions(resp.ScatterPositions),
 		ScatterPayout:    resp.ScatterPayout,
 		AwardedFreeSpins: resp.AwardedFreeSpins,
 		TotalPayout:      resp.TotalPayout,
 		Balance:          resp.Balance,
 		FreeSpinCount:    resp.FreeSpinCount,
 		InFreeSpin:       resp.InFreeSpin,
+		MaxWinReached:    resp.MaxWinReached,
+		Lines:            resp.Lines,
+		MoneyCells:       toMoneyCells(resp.MoneyCells),
+		HoldAndWin:       toHoldAndWinPtr(resp.HoldAndWin),
+		Gamble:           toGamble(resp.Gamble),
+		PickEm:           toPickEmPtr(resp.PickEm),
+		Wheel:            toWheel(resp.Wheel),
+		Jackpots:         toJackpotWins(resp.Jackpots),
 	}
 }
 
@@ -29,10 +45,47 @@ func toLineWins(lineWins []model.LineWin) []dto.LineWin {
 	result := make([]dto.LineWin, len(lineWins))
 	for i, line := range lineWins {
 		result[i] = dto.LineWin{
-			Line:   line.Line,
-			Symbol: line.Symbol,
-			Count:  line.Count,
-			Payout: line.Payout,
+			Line:       line.Line,
+			Symbol:     line.Symbol,
+			Count:      line.Count,
+			Payout:     line.Payout,
+			Ways:       line.Ways,
+			Positions:  toLinePositions(line.Positions),
+			Direction:  line.Direction,
+			Multiplier: line.Multiplier,
+		}
+	}
+	return result
+}
+
+func toTumbleSteps(steps []model.LineTumbleStep) []dto.TumbleStep {
+	result := make([]dto.TumbleStep, len(steps))
+	for i, step := range steps {
+		newSymbols := make([]dto.LineSymbol, len(step.NewSymbols))
+		for j, ns := range step.NewSymbols {
+			newSymbols[j] = dto.LineSymbol{
+				Position: dto.LinePosition{Reel: ns.Reel, Row: ns.Row},
+				Symbol:   ns.Symbol,
+			}
+		}
+		result[i] = dto.TumbleStep{
+			Index:      step.Index,
+			Board:      step.Board,
+			Wins:       toLineWins(step.Wins),
+			Multiplier: step.Multiplier,
+			Removed:    toLinePositions(step.Removed),
+			NewSymbols: newSymbols,
+		}
+	}
+	return result
+}
+
+func toLinePositions(positions []model.LinePosition) []dto.LinePosition {
+	result := make([]dto.LinePosition, len(positions))
+	for i, p := range positions {
+		result[i] = dto.LinePosition{
+			Reel: p.Reel,
+			Row:  p.Row,
 		}
 	}
 	return result
@@ -42,5 +95,131 @@ func ToDataResponse(data model.Data) dto.DataResponse {
 	return dto.DataResponse{
 		Balance:       data.Balance,
 		FreeSpinCount: data.FreeSpinCount,
+		HoldAndWin:    toHoldAndWinPtr(data.HoldAndWin),
+		Gamble:        toGamble(data.Gamble),
+		PickEm:        toPickEmPtr(data.PickEm),
+		Wheel:         toWheel(data.Wheel),
+	}
+}
+
+func ToHoldAndWinRespinResponse(res model.HoldAndWinResult) dto.HoldAndWinRespinResponse {
+	return dto.HoldAndWinRespinResponse{
+		HoldAndWin:  toHoldAndWin(res.State),
+		NewCells:    toMoneyCells(res.NewCells),
+		Finished:    res.Finished,
+		Grand:       res.Grand,
+		TotalPayout: res.TotalPayout,
+		Balance:     res.Balance,
+	}
+}
+
+func toHoldAndWinPtr(state *model.HoldAndWinState) *dto.HoldAndWin {
+	if state == nil {
+		return nil
+	}
+	result := toHoldAndWin(*state)
+	return &result
+}
+
+func toHoldAndWin(state model.HoldAndWinState) dto.HoldAndWin {
+	return dto.HoldAndWin{
+		Bet:         state.Bet,
+		RespinsLeft: state.RespinsLeft,
+		Cells:       toMoneyCells(state.Cells),
+	}
+}
+
+func toMoneyCells(cells []model.MoneyCell) []dto.MoneyCell {
+	result := make([]dto.MoneyCell, len(cells))
+	for i, c := range cells {
+		result[i] = dto.MoneyCell{
+			Position: dto.LinePosition{Reel: c.Reel, Row: c.Row},
+			Value:    c.Value,
+			Jackpot:  c.Jackpot,
+		}
+	}
+	return result
+}
+
+func ToGambleResponse(res model.GambleResult) dto.GambleResponse {
+	var gamble *dto.Gamble
+	if res.State.Active {
+		gamble = toGamble(&res.State)
+	}
+	return dto.GambleResponse{
+		Choice:  res.Choice,
+		Card:    dto.GambleCard{Rank: res.Card.Rank, Suit: res.Card.Suit},
+		Won:     res.Won,
+		Gamble:  gamble,
+		Balance: res.Balance,
+	}
+}
+
+func ToCollectResponse(res model.GambleCollect) dto.CollectResponse {
+	return dto.CollectResponse{
+		Amount:  res.Amount,
+		Balance: res.Balance,
+	}
+}
+
+func toGamble(state *model.GambleState) *dto.Gamble {
+	if state == nil {
+		return nil
+	}
+	return &dto.Gamble{
+		Win:       state.Win,
+		Step:      state.Step,
+		CanGamble: state.CanGamble,
+	}
+}
+
+func ToPickEmPickResponse(res model.PickEmResult) dto.PickEmPickResponse {
+	return dto.PickEmPickResponse{
+		Pick:        toPickEmPick(res.Pick, res.State.Bet),
+		PickEm:      toPickEm(res.State),
+		Finished:    res.Finished,
+		Unpicked:    toPickEmPicks(res.Unpicked, res.State.Bet),
+		TotalPayout: res.TotalPayout,
+		Balance:     res.Balance,
+	}
+}
+
+func toPickEmPtr(state *model.PickEmState) *dto.PickEm {
+	if state == nil {
+		return nil
+	}
+	result := toPickEm(*state)
+	return &result
+}
+
+// toPickEm отдаёт только открытые ячейки — содержимое остальных клиент не видит
+func toPickEm(state model.PickEmState) dto.PickEm {
+	return dto.PickEm{
+		Cells:      len(state.Prizes),
+		Picks:      toPickEmPicks(state.Picks, state.Bet),
+		PicksLeft:  state.PicksLeft,
+		Credits:    state.Credits * state.Bet / 100,
+		Multiplier: state.Multiplier,
+	}
+}
+
+func toPickEmPicks(picks []model.PickEmPick, bet int) []dto.PickEmPick {
+	result := make([]dto.PickEmPick, len(picks))
+	for i, p := range picks {
+		result[i] = toPickEmPick(p, bet)
+	}
+	return result
+}
+
+func toPickEmPick(pick model.PickEmPick, bet int) dto.PickEmPick {
+	value := pick.Prize.Value
+	// Кредиты хранятся в сотых долях ставки — клиенту отдаём деньги
+	if pick.Prize.Type == config.PickEmPrizeCredit {
+		value = value * bet / 100
+	}
+	return dto.PickEmPick{
+		Index: pick.Index,
+		Type:  pick.Prize.Type,
+		Value: value,
 	}
 }
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+	"slices"
+)
+
+func ToMinesStart(req dto.MinesStartRequest) model.MinesStart {
+	return model.MinesStart{
+		PlayerID: req.PlayerID,
+		Bet:      req.Bet,
+		Mines:    req.Mines,
+	}
+}
+
+// ToMinesRoundResponse пока раунд идёт, расклад и соль не отдаются — только хэш
+func ToMinesRoundResponse(round model.MinesRound) dto.MinesRoundResponse {
+	res := dto.MinesRoundResponse{
+		PlayerID:       round.PlayerID,
+		Status:         round.Status,
+		Bet:            round.Bet,
+		Mines:          round.Mines,
+		Revealed:       make([]int, len(round.Revealed)),
+		Multiplier:     round.Multiplier,
+		NextMultiplier: round.NextMultiplier,
+		Payout:         round.Payout,
+		Hash:           round.Hash,
+	}
+	copy(res.Revealed, round.Revealed)
+	if round.HitMine >= 0 {
+		hit := round.HitMine
+		res.HitMine = &hit
+	}
+	if round.Status != model.MinesStatusActive {
+		res.Salt = round.Salt
+		res.Layout = slices.Sorted(slices.Values(round.Layout))
+	}
+	return res
+}
+
+func ToMinesStateResponse(state model.MinesState) dto.MinesStateResponse {
+	res := dto.MinesStateResponse{Balance: state.Balance}
+	if state.Round != nil {
+		round := ToMinesRoundResponse(*state.Round)
+		res.Round = &round
+	}
+	return res
+}
@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToPlinkoDrop(req dto.PlinkoDropRequest) model.PlinkoDrop {
+	return model.PlinkoDrop{
+		Bet:  req.Bet,
+		Rows: req.Rows,
+		Risk: req.Risk,
+	}
+}
+
+func ToPlinkoDropResponse(res model.PlinkoDropResult) dto.PlinkoDropResponse {
+	return dto.PlinkoDropResponse{
+		Bet:        res.Bet,
+		Rows:       res.Rows,
+		Risk:       res.Risk,
+		Path:       res.Path,
+		Bucket:     res.Bucket,
+		Multiplier: res.Multiplier,
+		Payout:     res.Payout,
+		Balance:    res.Balance,
+	}
+}
+
+func ToPlinkoGameInfoResponse(info model.PlinkoGameInfo) dto.PlinkoGameInfoResponse {
+	ladder := make([]int, len(info.BetLadder))
+	copy(ladder, info.BetLadder)
+
+	tables := make([]dto.PlinkoTable, len(info.Tables))
+	for i, t := range info.Tables {
+		tables[i] = dto.PlinkoTable{
+			Risk:        t.Risk,
+			Rows:        t.Rows,
+			Multipliers: t.Multipliers,
+		}
+	}
+	return dto.PlinkoGameInfoResponse{
+		MinRows:   info.MinRows,
+		MaxRows:   info.MaxRows,
+		MinBet:    info.MinBet,
+		MaxBet:    info.MaxBet,
+		BetLadder: ladder,
+		Tables:    tables,
+	}
+}
@@ -0,0 +1,54 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToRouletteBets(req dto.RouletteSpinRequest) []model.RouletteBet {
+	bets := make([]model.RouletteBet, len(req.Bets))
+	for i, bet := range req.Bets {
+		bets[i] = model.RouletteBet{
+			Type:    bet.Type,
+			Numbers: bet.Numbers,
+			Amount:  bet.Amount,
+		}
+	}
+	return bets
+}
+
+func ToRouletteSpinResponse(res model.RouletteSpinResult) dto.RouletteSpinResponse {
+	bets := make([]dto.RouletteBetResult, len(res.Bets))
+	for i, bet := range res.Bets {
+		numbers := bet.Numbers
+		if numbers == nil {
+			numbers = []int{}
+		}
+		bets[i] = dto.RouletteBetResult{
+			Type:    bet.Type,
+			Numbers: numbers,
+			Amount:  bet.Amount,
+			Won:     bet.Won,
+			Payout:  bet.Payout,
+		}
+	}
+	return dto.RouletteSpinResponse{
+		Number:      res.Number,
+		Color:       res.Color,
+		Bets:        bets,
+		TotalBet:    res.TotalBet,
+		TotalPayout: res.TotalPayout,
+		Balance:     res.Balance,
+	}
+}
+
+func ToRouletteDataResponse(data model.RouletteData) dto.RouletteDataResponse {
+	history := data.History
+	if history == nil {
+		history = []int{}
+	}
+	return dto.RouletteDataResponse{
+		Balance: data.Balance,
+		History: history,
+	}
+}
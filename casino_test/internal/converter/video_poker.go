@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToVideoPokerDealResponse(res model.VideoPokerDeal) dto.VideoPokerDealResponse {
+	return dto.VideoPokerDealResponse{
+		Bet:     res.Bet,
+		Hand:    ToCards(res.Hand),
+		Combo:   res.Combo,
+		Balance: res.Balance,
+	}
+}
+
+func ToVideoPokerDrawResponse(res model.VideoPokerDraw) dto.VideoPokerDrawResponse {
+	return dto.VideoPokerDrawResponse{
+		Bet:     res.Bet,
+		Hand:    ToCards(res.Hand),
+		Held:    res.Held,
+		Combo:   res.Combo,
+		Payout:  res.Payout,
+		Balance: res.Balance,
+	}
+}
+
+func ToVideoPokerDataResponse(data model.VideoPokerData) dto.VideoPokerDataResponse {
+	res := dto.VideoPokerDataResponse{
+		Balance:  data.Balance,
+		PayTable: data.PayTable,
+	}
+	if data.Hand != nil {
+		hand := ToVideoPokerDealResponse(*data.Hand)
+		res.Hand = &hand
+	}
+	return res
+}
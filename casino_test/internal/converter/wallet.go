@@ -0,0 +1,10 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToWalletDataResponse(data model.WalletData) dto.WalletDataResponse {
+	return dto.WalletDataResponse{Balance: data.Balance}
+}
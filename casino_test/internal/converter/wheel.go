@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"casino_test/internal/api/dto"
+	"casino_test/internal/model"
+)
+
+func ToWheelSpinResponse(res model.WheelResult) dto.WheelSpinResponse {
+	return dto.WheelSpinResponse{
+		WheelID:       res.Spin.WheelID,
+		SegmentIndex:  res.Spin.SegmentIndex,
+		Segment:       toWheelSegment(res.Spin.Segment),
+		Angle:         res.Spin.Angle,
+		Finished:      res.Spin.Finished,
+		Payout:        res.Spin.Payout,
+		FreeSpins:     res.Spin.FreeSpins,
+		Next:          toWheel(res.Spin.Next),
+		Balance:       res.Balance,
+		FreeSpinCount: res.FreeSpinCount,
+	}
+}
+
+func toWheel(view *model.WheelView) *dto.Wheel {
+	if view == nil {
+		return nil
+	}
+	segments := make([]dto.WheelSegment, len(view.Segments))
+	for i, seg := range view.Segments {
+		segments[i] = toWheelSegment(seg)
+	}
+	return &dto.Wheel{
+		WheelID:    view.State.WheelID,
+		Level:      view.State.Level,
+		Multiplier: view.State.Multiplier,
+		Segments:   segments,
+	}
+}
+
+func toWheelSegment(seg model.WheelSegment) dto.WheelSegment {
+	return dto.WheelSegment{Type: seg.Type, Value: seg.Value}
+}
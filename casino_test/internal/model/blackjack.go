@@ -0,0 +1,69 @@
+package model
+
+// Фазы раунда блэкджека
+const (
+	BlackjackPhaseInsurance = "insurance" // у дилера открыт туз: игрок решает, брать ли страховку
+	BlackjackPhasePlayer    = "player"    // игрок ходит по своим рукам
+	BlackjackPhaseFinished  = "finished"  // раунд рассчитан
+)
+
+// Итоги руки
+const (
+	BlackjackResultBlackjack = "blackjack"
+	BlackjackResultWin       = "win"
+	BlackjackResultPush      = "push"
+	BlackjackResultLose      = "lose"
+	BlackjackResultBust      = "bust"
+	BlackjackResultSurrender = "surrender"
+)
+
+// Действия игрока
+const (
+	BlackjackActionDeal      = "deal"
+	BlackjackActionHit       = "hit"
+	BlackjackActionStand     = "stand"
+	BlackjackActionDouble    = "double"
+	BlackjackActionSplit     = "split"
+	BlackjackActionInsurance = "insurance"
+	BlackjackActionSurrender = "surrender"
+)
+
+// BlackjackHand рука игрока
+type BlackjackHand struct {
+	Cards   []Card
+	Bet     int
+	Doubled bool
+	Split   bool // Рука получена сплитом: 21 на двух картах — не блэкджек
+	Done    bool // Ход по руке закончен
+	Result  string
+	Payout  int  // Выплата вместе со ставкой
+	Value   int  // Сумма очков (заполняется для ответа)
+	Soft    bool // Туз считается за 11 (заполняется для ответа)
+}
+
+// BlackjackRound раунд за столом
+type BlackjackRound struct {
+	Phase           string
+	Bet             int // Исходная ставка
+	Hands           []BlackjackHand
+	Current         int // Рука, по которой сейчас ход
+	Dealer          []Card
+	Insurance       int // Ставка страховки (0 — не брали)
+	InsurancePayout int
+	TotalPayout     int // Всё, что зачислено по итогам раунда
+}
+
+// BlackjackState шуз и текущий раунд между действиями игрока
+type BlackjackState struct {
+	Shoe  []Card // Оставшиеся карты в порядке выдачи
+	Round BlackjackRound
+}
+
+// BlackjackTable состояние стола для клиента. Пока раунд идёт, скрытая карта дилера не отдаётся.
+type BlackjackTable struct {
+	Round       *BlackjackRound // nil — ещё не играли
+	DealerValue int             // Очки по видимым картам дилера
+	Actions     []string        // Доступные сейчас действия
+	ShoeLeft    int             // Карт осталось в шузе
+	Balance     int
+}
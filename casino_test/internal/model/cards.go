@@ -0,0 +1,23 @@
+package model
+
+// Масти карт
+const (
+	SuitHearts   = "hearts"
+	SuitDiamonds = "diamonds"
+	SuitClubs    = "clubs"
+	SuitSpades   = "spades"
+)
+
+// Старшие карты (младшие — от 2 до 10 по номиналу)
+const (
+	RankJack  = 11
+	RankQueen = 12
+	RankKing  = 13
+	RankAce   = 14
+)
+
+// Card игральная карта
+type Card struct {
+	Rank int // 2..14, туз — 14
+	Suit string
+}
@@ -40,10 +40,44 @@ type CascadeSpinResult struct {
 	AwardedFreeSpins int           // Количество начисленных фриспинов
 	FreeSpinsLeft    int           // Остаток фриспинов после спина
 	InFreeSpin       bool          // Находится ли игрок в режиме фриспинов
+	MaxWinReached    bool          // Достигнут лимит максимального выигрыша, раунд остановлен
+	Wheel            *WheelView    // Запущенное колесо фортуны (nil — не запущено)
+	Jackpots         []JackpotWin  // Выигранные джекпоты (уже на балансе)
 }
 
 // CascadeData содержит информацию о балансе и количестве фриспинов игрока
 type CascadeData struct {
-	Balance       int // Теперь экспортировано (большая буква)
-	FreeSpinCount int // Теперь экспортировано
+	Balance       int        // Теперь экспортировано (большая буква)
+	FreeSpinCount int        // Теперь экспортировано
+	Wheel         *WheelView // Незавершённое колесо фортуны
+}
+
+// CascadeBuyBonus запрос на покупку бонуса
+type CascadeBuyBonus struct {
+	ProductID string
+	Bet       int
+}
+
+// CascadeBuyBonusResult результат покупки бонуса
+type CascadeBuyBonusResult struct {
+	ProductID     string
+	Cost          int // Списано с баланса
+	AwardedSpins  int // Выдано фриспинов
+	Balance       int // Баланс после покупки
+	FreeSpinsLeft int // Фриспинов на счету после покупки
+}
+
+// CascadeBonusBuyProduct продукт покупки бонуса для витрины
+type CascadeBonusBuyProduct struct {
+	ID              string
+	Name            string
+	PriceXBet       int // Цена в кратности ставки
+	FreeSpins       int // Количество фриспинов
+	StartMultiplier int // Стартовый множитель ячеек
+}
+
+// CascadeGameInfo статические параметры каскадной игры
+type CascadeGameInfo struct {
+	BonusBuys  []CascadeBonusBuyProduct
+	MaxWinXBet int
 }
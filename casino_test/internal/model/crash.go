@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// Фазы раунда краш-игры
+const (
+	CrashPhaseBetting = "betting" // приём ставок
+	CrashPhaseRunning = "running" // множитель растёт
+	CrashPhaseCrashed = "crashed" // раунд окончен
+)
+
+// Типы событий ленты краш-игры
+const (
+	CrashEventRound   = "round"   // новый раунд или смена фазы
+	CrashEventTick    = "tick"    // текущий множитель
+	CrashEventBet     = "bet"     // принята ставка
+	CrashEventCashout = "cashout" // игрок забрал выигрыш
+	CrashEventCrash   = "crash"   // краш, раскрыт seed
+)
+
+// CrashRound раунд краш-игры. Hash публикуется до начала раунда,
+// Seed и CrashPoint — только после краша: sha256(Seed) == Hash, а точка краша выводится из Seed.
+type CrashRound struct {
+	ID         int
+	Phase      string
+	Hash       string
+	Seed       string
+	CrashPoint float64
+	Multiplier float64
+	BetsUntil  time.Time // До какого момента принимаются ставки
+	StartedAt  time.Time // Когда начал расти множитель
+}
+
+// CrashBet ставка игрока в раунде
+type CrashBet struct {
+	PlayerID    string // Имя за столом (видно всем, ставку по нему не забрать)
+	Token       string // Секрет ставки: выдаётся только поставившему клиенту, по нему забирается выигрыш
+	Amount      int
+	AutoCashout float64 // Автоматически забрать на этом множителе (0 — вручную)
+	CashedOut   bool
+	CashoutAt   float64
+	Payout      int
+}
+
+// CrashEvent событие ленты для подключённых игроков
+type CrashEvent struct {
+	Type  string
+	Round CrashRound
+	Bet   *CrashBet
+}
+
+// CrashState текущий раунд со ставками (для восстановления UI)
+type CrashState struct {
+	Round   CrashRound
+	Bets    []CrashBet
+	History []CrashRound // Последние завершённые раунды
+}
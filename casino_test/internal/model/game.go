@@ -0,0 +1,20 @@
+package model
+
+// Категории игр
+const (
+	GameCategorySlot    = "slot"
+	GameCategoryTable   = "table"
+	GameCategoryCards   = "cards"
+	GameCategoryInstant = "instant"
+	GameCategoryLottery = "lottery"
+)
+
+// GameMeta описание игры для списка игр
+type GameMeta struct {
+	ID          string
+	Name        string
+	Category    string
+	Description string
+	Path        string // Где смонтированы маршруты игры: /games/{id}
+	LegacyPath  string // Старый адрес маршрутов ("" — нет)
+}
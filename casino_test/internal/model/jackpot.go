@@ -0,0 +1,14 @@
+package model
+
+// JackpotPool текущее состояние пула джекпота
+type JackpotPool struct {
+	ID     string
+	Value  float64 // Накопленная сумма (с дробной частью от мелких отчислений)
+	DropAt float64 // Где выпадет пул с must-drop (клиенту не отдаётся)
+}
+
+// JackpotWin выигрыш джекпота в спине
+type JackpotWin struct {
+	ID     string
+	Amount int
+}
@@ -0,0 +1,46 @@
+package model
+
+// KenoPurchase покупка билета
+type KenoPurchase struct {
+	Picks []int // Отмеченные числа 1..80
+	Bet   int   // Ставка на один тираж
+	Draws int   // Сколько тиражей подряд играет билет
+}
+
+// KenoDraw результат билета в одном тираже
+type KenoDraw struct {
+	DrawID     int   // Сквозной номер тиража
+	Numbers    []int // 20 выпавших чисел по возрастанию
+	Hits       []int // Совпавшие отметки
+	Multiplier float64
+	Payout     int
+}
+
+// KenoTicket билет на несколько тиражей подряд
+type KenoTicket struct {
+	ID          int
+	Picks       []int
+	Bet         int
+	Draws       int
+	TotalBet    int
+	TotalPayout int
+	Results     []KenoDraw // По тиражу на каждый розыгрыш билета, по порядку
+}
+
+// KenoTicketResult купленный и разыгранный билет и баланс после
+type KenoTicketResult struct {
+	Ticket  KenoTicket
+	Balance int
+}
+
+// KenoGameInfo статические параметры кено
+type KenoGameInfo struct {
+	Numbers  int
+	Drawn    int
+	MinPicks int
+	MaxPicks int
+	MaxDraws int
+	MinBet   int
+	MaxBet   int
+	PayTable map[int]map[int]float64
+}
@@ -1,29 +1,172 @@
 package model
 
 type LineSpin struct {
-	Bet int
+	Bet   int
+	Lines int // Сколько линий играет (1..N), 0 — все
 }
 
 type SpinResult struct {
-	Board            [5][3]string
+	InitialBoard     [5][3]string     // Поле до каскадов
+	Board            [5][3]string     // Итоговое поле
+	Tumbles          []LineTumbleStep // Шаги каскадов (пусто, если режим выключен)
+	WildReels        []int            // Барабаны, целиком ставшие вайлдами
+	WildMultipliers  []int            // Множители вайлд-барабанов (в порядке WildReels)
+	SpinMultiplier   int              // Общий множитель выигрышей фриспина
 	LineWins         []LineWin
 	ScatterCount     int
+	ScatterPositions []LinePosition // Позиции скаттеров, если они принесли выплату или фриспины
 	ScatterPayout    int
 	AwardedFreeSpins int
 	TotalPayout      int
 	Balance          int
 	FreeSpinCount    int
 	InFreeSpin       bool
+	MaxWinReached    bool             // Достигнут лимит выигрыша, оставшиеся фриспины сгорели
+	Lines            int              // Сыгранных линий (ставка на линию = Bet / Lines)
+	MoneyCells       []MoneyCell      // Денежные символы на итоговом поле
+	HoldAndWin       *HoldAndWinState // Запущенный бонус hold and win (nil — не запущен)
+	Gamble           *GambleState     // Выигрыш ждёт риска или сбора (nil — выигрыш зачислен)
+	PickEm           *PickEmState     // Запущенный бонус pick-em (nil — не запущен)
+	Wheel            *WheelView       // Запущенное колесо фортуны (nil — не запущено)
+	Jackpots         []JackpotWin     // Выигранные джекпоты (уже на балансе)
 }
 
 type LineWin struct {
-	Line   int
-	Symbol string
-	Count  int
-	Payout int
+	Line       int
+	Symbol     string
+	Count      int
+	Payout     int
+	Ways       int            // Количество путей (режим ways), для линий 0
+	Positions  []LinePosition // Ячейки, участвующие в выигрыше
+	Direction  string         // Направление выплаты: ltr или rtl
+	Multiplier int            // Итоговый множитель выигрыша (вайлды × множитель фриспина)
+}
+
+// LinePosition координаты ячейки линейного слота
+type LinePosition struct {
+	Reel int
+	Row  int
 }
 
 type Data struct {
-	Balance       int // Теперь экспортировано (большая буква)
-	FreeSpinCount int // Теперь экспортировано
+	Balance       int              // Теперь экспортировано (большая буква)
+	FreeSpinCount int              // Теперь экспортировано
+	HoldAndWin    *HoldAndWinState // Незавершённый бонус hold and win (для восстановления UI)
+	Gamble        *GambleState     // Несобранный выигрыш в игре на удвоение
+	PickEm        *PickEmState     // Незавершённый бонус pick-em
+	Wheel         *WheelView       // Незавершённое колесо фортуны
+}
+
+// LineFreeSpinState ставка и модификаторы, действующие до конца бонуса
+type LineFreeSpinState struct {
+	Bet            int         // Ставка, с которой начался бонус: фриспины платят от неё
+	Lines          int         // Сыгранных линий при запуске бонуса
+	FeatureWin     int         // Выигрыш, уже набранный в бонусе (лимит действует на весь бонус)
+	WildReels      map[int]int // Липкие вайлд-барабаны и их множители
+	SpinMultiplier int         // Множитель текущего фриспина (растёт с каждым спином)
+}
+
+// LineTumbleStep один шаг каскада линейного слота
+type LineTumbleStep struct {
+	Index      int             // Номер шага (0 — начальное поле)
+	Board      [5][3]string    // Поле, на котором посчитаны выигрыши шага
+	Wins       []LineWin       // Выигрыши шага (уже с множителем шага)
+	Multiplier int             // Множитель шага
+	Removed    []LinePosition  // Удалённые ячейки
+	NewSymbols []LineNewSymbol // Какие символы упали и куда
+}
+
+// LineNewSymbol упавший символ
+type LineNewSymbol struct {
+	LinePosition
+	Symbol string
+}
+
+// MoneyCell денежный символ на поле: значение в деньгах или джекпот
+type MoneyCell struct {
+	LinePosition
+	Value   int    // Сколько платит символ (в деньгах, уже от ставки)
+	Jackpot string // mini/minor/major, если символ — джекпот
+}
+
+// HoldAndWinState состояние бонуса hold and win (респины с залипающими денежными символами)
+type HoldAndWinState struct {
+	Active      bool
+	Bet         int         // Ставка спина, запустившего бонус
+	RespinsLeft int         // Оставшиеся респины (сбрасываются при новом символе)
+	Cells       []MoneyCell // Залипшие денежные символы
+}
+
+// HoldAndWinResult результат одного респина
+type HoldAndWinResult struct {
+	State       HoldAndWinState
+	NewCells    []MoneyCell // Символы, выпавшие в этом респине
+	Finished    bool        // Бонус закончился (респины кончились или поле заполнено)
+	Grand       bool        // Поле заполнено целиком — выдан grand
+	TotalPayout int         // Выплата за бонус (только при завершении)
+	Balance     int
+}
+
+// GambleState выигрыш, который игрок может рискнуть или забрать
+type GambleState struct {
+	Active    bool
+	Bet       int  // Ставка спина (от неё считается лимит)
+	Win       int  // Текущий несобранный выигрыш
+	Step      int  // Сколько раз уже рискнули
+	CanGamble bool // Можно ли рискнуть ещё раз (шаги и лимит не исчерпаны)
+}
+
+// GambleCard открытая карта
+type GambleCard struct {
+	Rank string
+	Suit string
+}
+
+// GambleResult результат одной попытки удвоения
+type GambleResult struct {
+	Choice  string
+	Card    GambleCard
+	Won     bool
+	State   GambleState
+	Balance int
+}
+
+// GambleCollect результат сбора выигрыша
+type GambleCollect struct {
+	Amount  int
+	Balance int
+}
+
+// PickEmPrize приз pick-em
+type PickEmPrize struct {
+	Type  string
+	Value int
+}
+
+// PickEmPick открытая ячейка pick-em
+type PickEmPick struct {
+	Index int
+	Prize PickEmPrize
+}
+
+// PickEmState состояние бонуса pick-em. Призы раскладываются при запуске,
+// поэтому перезапуск клиента не меняет того, что лежит в ячейках.
+type PickEmState struct {
+	Active     bool
+	Bet        int
+	Prizes     []PickEmPrize // Разложенные призы (клиенту не отдаются)
+	Picks      []PickEmPick  // Открытые ячейки по порядку
+	PicksLeft  int
+	Credits    int // Собранные выигрыши (в сотых долях ставки)
+	Multiplier int // Общий множитель (произведение выпавших множителей)
+}
+
+// PickEmResult результат одного выбора
+type PickEmResult struct {
+	Pick        PickEmPick
+	State       PickEmState
+	Finished    bool
+	Unpicked    []PickEmPick // Что лежало в остальных ячейках (только при завершении)
+	TotalPayout int          // Выплата за бонус (только при завершении)
+	Balance     int
 }
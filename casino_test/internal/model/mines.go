@@ -0,0 +1,38 @@
+package model
+
+// Статусы раунда Mines
+const (
+	MinesStatusActive    = "active"     // игрок открывает клетки
+	MinesStatusBusted    = "busted"     // открыта мина, ставка проиграна
+	MinesStatusCashedOut = "cashed_out" // игрок забрал выигрыш
+)
+
+// MinesStart параметры нового раунда
+type MinesStart struct {
+	PlayerID string
+	Bet      int
+	Mines    int
+}
+
+// MinesRound раунд игрока. Расклад мин выбирается при старте и не меняется:
+// Hash = sha256(Salt:мины) публикуется сразу, Salt и Layout — после окончания раунда.
+type MinesRound struct {
+	PlayerID       string
+	Status         string
+	Bet            int
+	Mines          int
+	Layout         []int // Номера клеток с минами (0..24, строка * 5 + колонка)
+	Revealed       []int // Открытые безопасные клетки по порядку
+	Hash           string
+	Salt           string
+	Multiplier     float64 // Текущий множитель (за уже открытые клетки)
+	NextMultiplier float64 // Множитель после следующей безопасной клетки (0 — открывать больше нечего)
+	HitMine        int     // Клетка, на которой подорвался игрок (-1 — нет)
+	Payout         int
+}
+
+// MinesState раунд игрока и баланс
+type MinesState struct {
+	Round   *MinesRound // nil — игрок ещё не играл
+	Balance int
+}
@@ -0,0 +1,41 @@
+package model
+
+// Направления отскока шарика от колышка
+const (
+	PlinkoLeft  = "L"
+	PlinkoRight = "R"
+)
+
+// PlinkoDrop параметры броска шарика
+type PlinkoDrop struct {
+	Bet  int
+	Rows int
+	Risk string
+}
+
+// PlinkoDropResult результат броска
+type PlinkoDropResult struct {
+	PlinkoDrop
+	Path       []string // Отскок на каждом ряду колышков: L или R
+	Bucket     int      // Лунка слева направо (0..Rows) — число отскоков вправо
+	Multiplier float64
+	Payout     int
+	Balance    int
+}
+
+// PlinkoTable множители лунок для риска и числа рядов
+type PlinkoTable struct {
+	Risk        string
+	Rows        int
+	Multipliers []float64
+}
+
+// PlinkoGameInfo статические параметры плинко
+type PlinkoGameInfo struct {
+	MinRows   int
+	MaxRows   int
+	MinBet    int
+	MaxBet    int
+	BetLadder []int
+	Tables    []PlinkoTable
+}
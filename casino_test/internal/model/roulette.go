@@ -0,0 +1,32 @@
+package model
+
+// RouletteBet одна ставка на столе
+type RouletteBet struct {
+	Type    string
+	Numbers []int // Числа ставки (для дюжины и колонки — её номер 1-3, для равных шансов — пусто)
+	Amount  int
+}
+
+// RouletteBetResult итог ставки
+type RouletteBetResult struct {
+	RouletteBet
+	Covered []int // Какие числа покрывает ставка
+	Won     bool
+	Payout  int // Выплата вместе с возвращённой ставкой (0 — проигрыш)
+}
+
+// RouletteSpinResult результат спина рулетки
+type RouletteSpinResult struct {
+	Number      int
+	Color       string // red/black/green
+	Bets        []RouletteBetResult
+	TotalBet    int
+	TotalPayout int
+	Balance     int
+}
+
+// RouletteData баланс и последние выпавшие числа
+type RouletteData struct {
+	Balance int
+	History []int // Последние числа, новые в начале
+}
@@ -0,0 +1,38 @@
+package model
+
+// Карт в руке видеопокера
+const VideoPokerHandSize = 5
+
+// VideoPokerState раздача между deal и draw. Колода хранится на сервере,
+// поэтому замены приходят из той же перемешанной колоды, что и первая раздача.
+type VideoPokerState struct {
+	Active bool
+	Bet    int
+	Hand   []Card
+	Deck   []Card // Оставшиеся карты в порядке выдачи
+}
+
+// VideoPokerDeal результат раздачи
+type VideoPokerDeal struct {
+	Bet     int
+	Hand    []Card
+	Combo   string // Комбинация на руках после раздачи (подсказка, выплата — только после draw)
+	Balance int
+}
+
+// VideoPokerDraw результат замены карт
+type VideoPokerDraw struct {
+	Bet     int
+	Hand    []Card
+	Held    []bool
+	Combo   string // Итоговая комбинация ("" — без выигрыша)
+	Payout  int
+	Balance int
+}
+
+// VideoPokerData баланс, незавершённая раздача и таблица выплат
+type VideoPokerData struct {
+	Balance  int
+	Hand     *VideoPokerDeal // Раздача, ждущая draw (nil — нет)
+	PayTable map[string]int
+}
@@ -0,0 +1,6 @@
+package model
+
+// WalletData баланс общего кошелька игрока
+type WalletData struct {
+	Balance int
+}
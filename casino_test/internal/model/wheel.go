@@ -0,0 +1,41 @@
+package model
+
+// WheelSegment сектор колеса фортуны
+type WheelSegment struct {
+	Type  string
+	Value int
+}
+
+// WheelState состояние бонуса колеса фортуны
+type WheelState struct {
+	Active     bool
+	Bet        int    // Ставка спина, запустившего колесо
+	WheelID    string // Текущее колесо (меняется сектором level_up)
+	Level      int    // 0 — внешнее колесо, дальше — внутренние
+	Multiplier int    // Накопленный множитель к выигрышу
+}
+
+// WheelView то, что нужно клиенту, чтобы нарисовать текущее колесо
+type WheelView struct {
+	State    WheelState
+	Segments []WheelSegment
+}
+
+// WheelSpin результат одного вращения колеса
+type WheelSpin struct {
+	WheelID      string
+	SegmentIndex int
+	Segment      WheelSegment
+	Angle        float64 // Угол остановки (градусы по часовой от начала сектора 0)
+	Finished     bool    // Колесо больше не крутится
+	Payout       int     // Выигрыш (в деньгах, с множителем)
+	FreeSpins    int     // Выигранные фриспины
+	Next         *WheelView
+}
+
+// WheelResult результат вращения колеса в игре
+type WheelResult struct {
+	Spin          WheelSpin
+	Balance       int
+	FreeSpinCount int
+}
@@ -0,0 +1,76 @@
+package registry
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Definition описание игры: C — конфиг, R — репозиторий, S — сервис.
+// Реализует Game, так что новая игра подключается одним значением Definition без правки реестра и роутера.
+type Definition[C, R, S any] struct {
+	ID          string
+	Name        string
+	Category    string
+	Description string
+	LegacyPath  string // Старый адрес маршрутов, который сохраняется для совместимости ("/" — корень)
+
+	LoadConfig    func(path string) (C, error)
+	NewRepository func(deps Deps) R // nil — у игры нет своего репозитория
+	NewService    func(cfg C, repo R, deps Deps) S
+	// Endpoints маршруты игры; их обслуживает общий обработчик реестра
+	Endpoints []Endpoint[S]
+	// Run фоновая работа игры (nil — нет)
+	Run func(ctx context.Context, serv S)
+
+	serv     S
+	handlers []http.HandlerFunc
+}
+
+func (d *Definition[C, R, S]) Meta() model.GameMeta {
+	return model.GameMeta{
+		ID:          d.ID,
+		Name:        d.Name,
+		Category:    d.Category,
+		Description: d.Description,
+		Path:        gamesPath + "/" + d.ID,
+		LegacyPath:  d.LegacyPath,
+	}
+}
+
+func (d *Definition[C, R, S]) Build(deps Deps) error {
+	cfg, err := d.LoadConfig(deps.ConfigPath)
+	if err != nil {
+		return err
+	}
+	var repo R
+	if d.NewRepository != nil {
+		repo = d.NewRepository(deps)
+	}
+	d.serv = d.NewService(cfg, repo, deps)
+	// Обработчики собираются один раз — основной адрес и старый используют одни и те же
+	d.handlers = make([]http.HandlerFunc, len(d.Endpoints))
+	for i, ep := range d.Endpoints {
+		d.handlers[i] = ep.handler(d.serv, deps)
+	}
+	return nil
+}
+
+func (d *Definition[C, R, S]) Routes(r chi.Router) {
+	for i, ep := range d.Endpoints {
+		r.Method(ep.Method, ep.Path, d.handlers[i])
+	}
+}
+
+func (d *Definition[C, R, S]) Start(ctx context.Context) {
+	if d.Run != nil {
+		go d.Run(ctx, d.serv)
+	}
+}
+
+// Service собранный сервис игры (после Build)
+func (d *Definition[C, R, S]) Service() S {
+	return d.serv
+}
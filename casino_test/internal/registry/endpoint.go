@@ -0,0 +1,105 @@
+package registry
+
+import (
+	"casino_test/pkg/req"
+	"casino_test/pkg/resp"
+	"errors"
+	"net/http"
+)
+
+// Endpoint маршрут игры относительно её префикса.
+// Описание задаёт типы запроса и ответа, а общий обработчик декодирует тело,
+// вызывает сервис и пишет ответ или ошибку — отдельный обработчик на каждую игру не нужен.
+type Endpoint[S any] struct {
+	Method string
+	Path   string
+
+	handler func(serv S, deps Deps) http.HandlerFunc
+}
+
+// Post POST с JSON-телом Req
+func Post[S, Req, Resp any](path string, call func(r *http.Request, serv S, req Req) (Resp, error)) Endpoint[S] {
+	return handle(http.MethodPost, path, true, call)
+}
+
+// Action POST без тела: действие над текущим состоянием игры
+func Action[S, Resp any](path string, call func(r *http.Request, serv S) (Resp, error)) Endpoint[S] {
+	return handle(http.MethodPost, path, false, withoutBody(call))
+}
+
+// Get GET; параметры, если нужны, берутся из адреса запроса
+func Get[S, Resp any](path string, call func(r *http.Request, serv S) (Resp, error)) Endpoint[S] {
+	return handle(http.MethodGet, path, false, withoutBody(call))
+}
+
+// Raw маршрут со своим обработчиком — для того, что не укладывается в запрос-ответ (WebSocket)
+func Raw[S any](method, path string, handler func(serv S, deps Deps) http.HandlerFunc) Endpoint[S] {
+	return Endpoint[S]{Method: method, Path: path, handler: handler}
+}
+
+// Reply переводит результат сервиса в ответ конвертером: Reply(converter.ToX)(serv.Method(...))
+func Reply[M, D any](conv func(M) D) func(*M, error) (D, error) {
+	return func(m *M, err error) (D, error) {
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		return conv(*m), nil
+	}
+}
+
+// BadRequest помечает ошибку как ошибку запроса: клиент получит 400 вместо 500
+func BadRequest(err error) error {
+	return badRequestError{err}
+}
+
+type badRequestError struct {
+	error
+}
+
+func (e badRequestError) Unwrap() error {
+	return e.error
+}
+
+// noBody тип запроса маршрутов без тела
+type noBody struct{}
+
+func withoutBody[S, Resp any](call func(r *http.Request, serv S) (Resp, error)) func(r *http.Request, serv S, _ noBody) (Resp, error) {
+	return func(r *http.Request, serv S, _ noBody) (Resp, error) {
+		return call(r, serv)
+	}
+}
+
+// handle общий обработчик: тело → сервис → JSON.
+// Ошибка декодирования и BadRequest — 400, остальные ошибки сервиса — 500
+func handle[S, Req, Resp any](method, path string, decode bool, call func(r *http.Request, serv S, req Req) (Resp, error)) Endpoint[S] {
+	return Endpoint[S]{
+		Method: method,
+		Path:   path,
+		handler: func(serv S, _ Deps) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				var payload Req
+				if decode {
+					var err error
+					payload, err = req.Decode[Req](r.Body)
+					if err != nil {
+						http.Error(w, err.Error(), http.StatusBadRequest)
+						return
+					}
+				}
+
+				response, err := call(r, serv, payload)
+				if err != nil {
+					status := http.StatusInternalServerError
+					var bad badRequestError
+					if errors.As(err, &bad) {
+						status = http.StatusBadRequest
+					}
+					http.Error(w, err.Error(), status)
+					return
+				}
+				resp.WriteJSONResponse(w, http.StatusOK, response)
+			}
+		},
+	}
+}
@@ -0,0 +1,110 @@
+// Package registry реестр игр. Игра описывается один раз — конфиг, репозиторий, сервис и маршруты,
+// а реестр собирает её на общих зависимостях и монтирует маршруты под /games/{id}.
+package registry
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"context"
+	"fmt"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// Префикс, под которым монтируются все игры
+const gamesPath = "/games"
+
+// Deps общие зависимости, которые реестр передаёт играм
+type Deps struct {
+	ConfigPath string
+	ServerCfg  config.ServerConfig
+	Wallet     repository.WalletRepository
+	RNG        rng.RNG
+	BetCfg     config.BetConfig
+	WheelCfg   config.WheelConfig
+	Jackpots   service.JackpotService
+}
+
+// Game игра в реестре
+type Game interface {
+	Meta() model.GameMeta
+	// Build загружает конфиг и создаёт репозиторий и сервис
+	Build(deps Deps) error
+	// Routes регистрирует маршруты игры относительно её префикса
+	Routes(r chi.Router)
+	// Start запускает фоновую работу игры (раунды по расписанию), если она есть
+	Start(ctx context.Context)
+}
+
+type Registry struct {
+	deps  Deps
+	games []Game
+	byID  map[string]Game
+}
+
+func New(deps Deps) *Registry {
+	return &Registry{
+		deps: deps,
+		byID: map[string]Game{},
+	}
+}
+
+// Register собирает игру и добавляет её в реестр
+func (reg *Registry) Register(game Game) error {
+	id := game.Meta().ID
+	if id == "" {
+		return fmt.Errorf("game id is required")
+	}
+	if _, ok := reg.byID[id]; ok {
+		return fmt.Errorf("game %q is already registered", id)
+	}
+	if err := game.Build(reg.deps); err != nil {
+		return fmt.Errorf("failed to build %s game: %w", id, err)
+	}
+
+	reg.games = append(reg.games, game)
+	reg.byID[id] = game
+	return nil
+}
+
+// Game возвращает игру по ID
+func (reg *Registry) Game(id string) (Game, bool) {
+	game, ok := reg.byID[id]
+	return game, ok
+}
+
+// List описания игр в порядке регистрации
+func (reg *Registry) List() []model.GameMeta {
+	metas := make([]model.GameMeta, len(reg.games))
+	for i, game := range reg.games {
+		metas[i] = game.Meta()
+	}
+	return metas
+}
+
+// Mount монтирует каждую игру под /games/{id} и по её старому адресу, если он есть
+func (reg *Registry) Mount(r chi.Router) {
+	for _, game := range reg.games {
+		meta := game.Meta()
+		r.Route(meta.Path, game.Routes)
+
+		switch meta.LegacyPath {
+		case "":
+		case "/":
+			// Первая игра жила в корне — её маршруты регистрируются прямо на роутере
+			game.Routes(r)
+		default:
+			r.Route(meta.LegacyPath, game.Routes)
+		}
+	}
+}
+
+// Start запускает фоновую работу всех игр
+func (reg *Registry) Start(ctx context.Context) {
+	for _, game := range reg.games {
+		game.Start(ctx)
+	}
+}
@@ -0,0 +1,43 @@
+package blackjackRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+type repo struct {
+	mtx   sync.RWMutex
+	state model.BlackjackState
+}
+
+func NewBlackjackRepository() repository.BlackjackRepository {
+	return &repo{}
+}
+
+func (r *repo) GetState() (model.BlackjackState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return cloneState(r.state), nil
+}
+
+func (r *repo) UpdateState(state model.BlackjackState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.state = cloneState(state)
+	return nil
+}
+
+// cloneState копирует шуз и руки, чтобы снаружи нельзя было поменять сохранённое состояние
+func cloneState(state model.BlackjackState) model.BlackjackState {
+	state.Shoe = slices.Clone(state.Shoe)
+	state.Round.Dealer = slices.Clone(state.Round.Dealer)
+	state.Round.Hands = slices.Clone(state.Round.Hands)
+	for i := range state.Round.Hands {
+		state.Round.Hands[i].Cards = slices.Clone(state.Round.Hands[i].Cards)
+	}
+	return state
+}
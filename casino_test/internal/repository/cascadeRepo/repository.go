@@ -1,15 +1,19 @@
 package cascadeRepo
 
 import (
+	"casino_test/internal/model"
 	"casino_test/internal/repository"
 	"sync"
 )
 
 type memoryData struct {
-	balance       int
 	freeSpinCount int
+	featureWin    int       // Выигрыш за текущий бонус
+	featureBet    int       // Ставка, с которой начался текущий бонус
+	featureMult   int       // Стартовый множитель ячеек текущего бонуса
 	mult          [7][7]int // Множители
 	hits          [7][7]int // Счётчики попаданий
+	wheel         model.WheelState
 }
 
 type repo struct {
@@ -21,45 +25,86 @@ func NewCascadeRepository() repository.CascadeRepository {
 	return &repo{mem: memoryData{}}
 }
 
-func (r *repo) GetBalance() (int, error) {
+func (r *repo) GetFreeSpinCount() (int, error) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 
-	return r.mem.balance, nil
+	return r.mem.freeSpinCount, nil
 }
 
-func (r *repo) UpdateBalance(amount int) error {
+func (r *repo) UpdateFreeSpinCount(count int) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
-	r.mem.balance = amount
+	r.mem.freeSpinCount = count
 	return nil
 }
 
-func (r *repo) GetFreeSpinCount() (int, error) {
+func (r *repo) GetFeatureWin() (int, error) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 
-	return r.mem.freeSpinCount, nil
+	return r.mem.featureWin, nil
 }
 
-func (r *repo) UpdateFreeSpinCount(count int) error {
+func (r *repo) UpdateFeatureWin(amount int) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
-	r.mem.freeSpinCount = count
+	r.mem.featureWin = amount
+	return nil
+}
+
+func (r *repo) GetFeatureBet() (int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.mem.featureBet, nil
+}
+
+func (r *repo) UpdateFeatureBet(bet int) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.featureBet = bet
+	return nil
+}
+
+func (r *repo) GetFeatureStartMultiplier() (int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	if r.mem.featureMult < 1 {
+		return 1, nil
+	}
+	return r.mem.featureMult, nil
+}
+
+func (r *repo) UpdateFeatureStartMultiplier(mult int) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.featureMult = mult
 	return nil
 }
 
-// ResetMultiplierState Сброс при начале платного спина
-func (r *repo) ResetMultiplierState() error {
+// ResetMultiplierState Сброс при начале платного спина.
+// start > 1 — ячейки сразу получают множитель (как будто по ним уже было два попадания)
+func (r *repo) ResetMultiplierState(start int) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
+	if start < 1 {
+		start = 1
+	}
+	hits := 0
+	if start > 1 {
+		hits = 2
+	}
 	for i := range r.mem.mult {
 		for j := range r.mem.mult[i] {
-			r.mem.mult[i][j] = 1
-			r.mem.hits[i][j] = 0
+			r.mem.mult[i][j] = start
+			r.mem.hits[i][j] = hits
 		}
 	}
 	return nil
@@ -78,3 +123,18 @@ func (r *repo) SetMultiplierState(mult, hits [7][7]int) error {
 	r.mem.hits = hits
 	return nil
 }
+
+func (r *repo) GetWheelState() (model.WheelState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.mem.wheel, nil
+}
+
+func (r *repo) UpdateWheelState(state model.WheelState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.wheel = state
+	return nil
+}
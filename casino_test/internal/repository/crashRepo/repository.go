@@ -0,0 +1,89 @@
+package crashRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+// Сколько завершённых раундов хранить
+const historySize = 20
+
+type memoryData struct {
+	round   model.CrashRound
+	bets    []model.CrashBet
+	history []model.CrashRound
+}
+
+type repo struct {
+	mtx sync.RWMutex
+	mem memoryData
+}
+
+func NewCrashRepository() repository.CrashRepository {
+	return &repo{mem: memoryData{}}
+}
+
+func (r *repo) GetRound() (model.CrashRound, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.mem.round, nil
+}
+
+func (r *repo) UpdateRound(round model.CrashRound) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.round = round
+	return nil
+}
+
+func (r *repo) GetBets() ([]model.CrashBet, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return slices.Clone(r.mem.bets), nil
+}
+
+// UpdateBet добавляет ставку игрока или обновляет уже сделанную
+func (r *repo) UpdateBet(bet model.CrashBet) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for i := range r.mem.bets {
+		if r.mem.bets[i].PlayerID == bet.PlayerID {
+			r.mem.bets[i] = bet
+			return nil
+		}
+	}
+	r.mem.bets = append(r.mem.bets, bet)
+	return nil
+}
+
+func (r *repo) ClearBets() error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.bets = nil
+	return nil
+}
+
+func (r *repo) GetHistory() ([]model.CrashRound, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return slices.Clone(r.mem.history), nil
+}
+
+func (r *repo) AddHistory(round model.CrashRound) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.history = append([]model.CrashRound{round}, r.mem.history...)
+	if len(r.mem.history) > historySize {
+		r.mem.history = r.mem.history[:historySize]
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package jackpotRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+type repo struct {
+	mtx   sync.RWMutex
+	pools []model.JackpotPool
+}
+
+func NewJackpotRepository() repository.JackpotRepository {
+	return &repo{}
+}
+
+func (r *repo) GetPools() ([]model.JackpotPool, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return slices.Clone(r.pools), nil
+}
+
+func (r *repo) UpdatePools(pools []model.JackpotPool) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.pools = slices.Clone(pools)
+	return nil
+}
@@ -0,0 +1,50 @@
+package kenoRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+// Сколько последних билетов хранить
+const historySize = 100
+
+type repo struct {
+	mtx     sync.RWMutex
+	tickets []model.KenoTicket
+}
+
+func NewKenoRepository() repository.KenoRepository {
+	return &repo{}
+}
+
+func (r *repo) GetTickets() ([]model.KenoTicket, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return slices.Clone(r.tickets), nil
+}
+
+func (r *repo) GetTicket(id int) (model.KenoTicket, bool, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	for _, ticket := range r.tickets {
+		if ticket.ID == id {
+			return ticket, true, nil
+		}
+	}
+	return model.KenoTicket{}, false, nil
+}
+
+func (r *repo) AddTicket(ticket model.KenoTicket) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.tickets = append([]model.KenoTicket{ticket}, r.tickets...)
+	if len(r.tickets) > historySize {
+		r.tickets = r.tickets[:historySize]
+	}
+	return nil
+}
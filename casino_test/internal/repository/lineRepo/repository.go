@@ -1,13 +1,20 @@
 package lineRepo
 
 import (
+	"casino_test/internal/model"
 	"casino_test/internal/repository"
+	"maps"
+	"slices"
 	"sync"
 )
 
 type memoryData struct {
-	balance       int
 	freeSpinCount int
+	freeSpinState model.LineFreeSpinState // Липкие вайлды и множители бонуса
+	holdAndWin    model.HoldAndWinState   // Бонус hold and win
+	gamble        model.GambleState       // Несобранный выигрыш игры на удвоение
+	pickEm        model.PickEmState       // Бонус pick-em
+	wheel         model.WheelState        // Бонус колеса фортуны
 }
 
 type repo struct {
@@ -19,32 +26,110 @@ func NewLineRepository() repository.LineRepository {
 	return &repo{mem: memoryData{}}
 }
 
-func (r *repo) GetBalance() (int, error) {
+func (r *repo) GetFreeSpinCount() (int, error) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 
-	return r.mem.balance, nil
+	return r.mem.freeSpinCount, nil
 }
 
-func (r *repo) UpdateBalance(amount int) error {
+func (r *repo) UpdateFreeSpinCount(count int) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
-	r.mem.balance = amount
+	r.mem.freeSpinCount = count
 	return nil
 }
 
-func (r *repo) GetFreeSpinCount() (int, error) {
+func (r *repo) GetFreeSpinState() (model.LineFreeSpinState, error) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 
-	return r.mem.freeSpinCount, nil
+	// Отдаём копию, чтобы сервис не менял состояние в обход UpdateFreeSpinState
+	state := r.mem.freeSpinState
+	state.WildReels = maps.Clone(state.WildReels)
+	if state.WildReels == nil {
+		state.WildReels = map[int]int{}
+	}
+	if state.SpinMultiplier < 1 {
+		state.SpinMultiplier = 1
+	}
+	return state, nil
 }
 
-func (r *repo) UpdateFreeSpinCount(count int) error {
+func (r *repo) UpdateFreeSpinState(state model.LineFreeSpinState) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
-	r.mem.freeSpinCount = count
+	state.WildReels = maps.Clone(state.WildReels)
+	r.mem.freeSpinState = state
+	return nil
+}
+
+func (r *repo) GetHoldAndWinState() (model.HoldAndWinState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	state := r.mem.holdAndWin
+	state.Cells = slices.Clone(state.Cells)
+	return state, nil
+}
+
+func (r *repo) UpdateHoldAndWinState(state model.HoldAndWinState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	state.Cells = slices.Clone(state.Cells)
+	r.mem.holdAndWin = state
+	return nil
+}
+
+func (r *repo) GetGambleState() (model.GambleState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.mem.gamble, nil
+}
+
+func (r *repo) UpdateGambleState(state model.GambleState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.gamble = state
+	return nil
+}
+
+func (r *repo) GetPickEmState() (model.PickEmState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	state := r.mem.pickEm
+	state.Prizes = slices.Clone(state.Prizes)
+	state.Picks = slices.Clone(state.Picks)
+	return state, nil
+}
+
+func (r *repo) UpdatePickEmState(state model.PickEmState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	state.Prizes = slices.Clone(state.Prizes)
+	state.Picks = slices.Clone(state.Picks)
+	r.mem.pickEm = state
+	return nil
+}
+
+func (r *repo) GetWheelState() (model.WheelState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.mem.wheel, nil
+}
+
+func (r *repo) UpdateWheelState(state model.WheelState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.mem.wheel = state
 	return nil
 }
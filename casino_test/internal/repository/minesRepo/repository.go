@@ -0,0 +1,41 @@
+package minesRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+type repo struct {
+	mtx sync.RWMutex
+	// Последний раунд каждого игрока (активный или завершённый)
+	rounds map[string]model.MinesRound
+}
+
+func NewMinesRepository() repository.MinesRepository {
+	return &repo{rounds: map[string]model.MinesRound{}}
+}
+
+func (r *repo) GetRound(playerID string) (model.MinesRound, bool, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	round, ok := r.rounds[playerID]
+	if !ok {
+		return model.MinesRound{}, false, nil
+	}
+	round.Layout = slices.Clone(round.Layout)
+	round.Revealed = slices.Clone(round.Revealed)
+	return round, true, nil
+}
+
+func (r *repo) UpdateRound(round model.MinesRound) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	round.Layout = slices.Clone(round.Layout)
+	round.Revealed = slices.Clone(round.Revealed)
+	r.rounds[round.PlayerID] = round
+	return nil
+}
@@ -1,19 +1,110 @@
 package repository
 
+import "casino_test/internal/model"
+
 type LineRepository interface {
-	GetBalance() (int, error)
-	UpdateBalance(amount int) error
 	GetFreeSpinCount() (int, error)
 	UpdateFreeSpinCount(count int) error
+
+	// Модификаторы текущего бонуса: липкие вайлды и множители
+	GetFreeSpinState() (model.LineFreeSpinState, error)
+	UpdateFreeSpinState(state model.LineFreeSpinState) error
+
+	// Бонус hold and win
+	GetHoldAndWinState() (model.HoldAndWinState, error)
+	UpdateHoldAndWinState(state model.HoldAndWinState) error
+
+	// Игра на удвоение (несобранный выигрыш)
+	GetGambleState() (model.GambleState, error)
+	UpdateGambleState(state model.GambleState) error
+
+	// Бонус pick-em
+	GetPickEmState() (model.PickEmState, error)
+	UpdatePickEmState(state model.PickEmState) error
+
+	// Бонус колеса фортуны
+	GetWheelState() (model.WheelState, error)
+	UpdateWheelState(state model.WheelState) error
 }
 
 type CascadeRepository interface {
-	GetBalance() (int, error)
-	UpdateBalance(amount int) error
 	GetFreeSpinCount() (int, error)
 	UpdateFreeSpinCount(count int) error
 
+	// Выигрыш, набранный за текущий бонус (для лимита максимального выигрыша)
+	GetFeatureWin() (int, error)
+	UpdateFeatureWin(amount int) error
+
+	// Ставка, с которой начался текущий бонус (фриспины платят и ограничиваются от неё)
+	GetFeatureBet() (int, error)
+	UpdateFeatureBet(bet int) error
+
+	// Стартовый множитель ячеек текущего бонуса (1 — чистое поле, >1 — купленный «супер» бонус)
+	GetFeatureStartMultiplier() (int, error)
+	UpdateFeatureStartMultiplier(mult int) error
+
 	GetMultiplierState() ([7][7]int, [7][7]int)
 	SetMultiplierState(mult, hits [7][7]int) error
-	ResetMultiplierState() error
+	ResetMultiplierState(start int) error
+
+	// Бонус колеса фортуны
+	GetWheelState() (model.WheelState, error)
+	UpdateWheelState(state model.WheelState) error
+}
+
+// WalletRepository кошелёк игрока, общий для всех игр
+type WalletRepository interface {
+	GetBalance() (int, error)
+	UpdateBalance(amount int) error
+	// Credit и Debit меняют баланс атомарно и возвращают новый баланс
+	Credit(amount int) (int, error)
+	Debit(amount int) (int, error)
+}
+
+type JackpotRepository interface {
+	GetPools() ([]model.JackpotPool, error)
+	UpdatePools(pools []model.JackpotPool) error
+}
+
+type RouletteRepository interface {
+	// Последние выпавшие числа (новые в начале)
+	GetHistory() ([]int, error)
+	AddResult(number int) error
+}
+
+type CrashRepository interface {
+	GetRound() (model.CrashRound, error)
+	UpdateRound(round model.CrashRound) error
+
+	// Ставки текущего раунда (по игроку)
+	GetBets() ([]model.CrashBet, error)
+	UpdateBet(bet model.CrashBet) error
+	ClearBets() error
+
+	// Завершённые раунды (новые в начале)
+	GetHistory() ([]model.CrashRound, error)
+	AddHistory(round model.CrashRound) error
+}
+
+type MinesRepository interface {
+	// Последний раунд игрока; false — игрок ещё не играл
+	GetRound(playerID string) (model.MinesRound, bool, error)
+	UpdateRound(round model.MinesRound) error
+}
+
+type VideoPokerRepository interface {
+	GetState() (model.VideoPokerState, error)
+	UpdateState(state model.VideoPokerState) error
+}
+
+type BlackjackRepository interface {
+	GetState() (model.BlackjackState, error)
+	UpdateState(state model.BlackjackState) error
+}
+
+type KenoRepository interface {
+	// Последние билеты (новые в начале)
+	GetTickets() ([]model.KenoTicket, error)
+	GetTicket(id int) (model.KenoTicket, bool, error)
+	AddTicket(ticket model.KenoTicket) error
 }
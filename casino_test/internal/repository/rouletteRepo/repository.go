@@ -0,0 +1,37 @@
+package rouletteRepo
+
+import (
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+// Сколько последних чисел хранить для табло
+const historySize = 20
+
+type repo struct {
+	mtx     sync.RWMutex
+	history []int
+}
+
+func NewRouletteRepository() repository.RouletteRepository {
+	return &repo{}
+}
+
+func (r *repo) GetHistory() ([]int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return slices.Clone(r.history), nil
+}
+
+func (r *repo) AddResult(number int) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.history = append([]int{number}, r.history...)
+	if len(r.history) > historySize {
+		r.history = r.history[:historySize]
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package videoPokerRepo
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"slices"
+	"sync"
+)
+
+type repo struct {
+	mtx   sync.RWMutex
+	state model.VideoPokerState
+}
+
+func NewVideoPokerRepository() repository.VideoPokerRepository {
+	return &repo{}
+}
+
+func (r *repo) GetState() (model.VideoPokerState, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	state := r.state
+	state.Hand = slices.Clone(state.Hand)
+	state.Deck = slices.Clone(state.Deck)
+	return state, nil
+}
+
+func (r *repo) UpdateState(state model.VideoPokerState) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	state.Hand = slices.Clone(state.Hand)
+	state.Deck = slices.Clone(state.Deck)
+	r.state = state
+	return nil
+}
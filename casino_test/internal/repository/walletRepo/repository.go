@@ -0,0 +1,59 @@
+package walletRepo
+
+import (
+	"casino_test/internal/repository"
+	"errors"
+	"sync"
+)
+
+type repo struct {
+	mtx     sync.RWMutex
+	balance int
+}
+
+// NewWalletRepository кошелёк игрока, общий для всех игр
+func NewWalletRepository() repository.WalletRepository {
+	return &repo{}
+}
+
+func (r *repo) GetBalance() (int, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.balance, nil
+}
+
+func (r *repo) UpdateBalance(amount int) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.balance = amount
+	return nil
+}
+
+func (r *repo) Credit(amount int) (int, error) {
+	if amount < 0 {
+		return 0, errors.New("credit amount must not be negative")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.balance += amount
+	return r.balance, nil
+}
+
+func (r *repo) Debit(amount int) (int, error) {
+	if amount < 0 {
+		return 0, errors.New("debit amount must not be negative")
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.balance < amount {
+		return r.balance, errors.New("not enough balance")
+	}
+	r.balance -= amount
+	return r.balance, nil
+}
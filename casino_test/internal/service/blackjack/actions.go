@@ -0,0 +1,159 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Hit добавляет карту в текущую руку; перебор или 21 заканчивают ход по руке
+func (s *serv) Hit(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, hand, err := s.playerTurn()
+	if err != nil {
+		return nil, err
+	}
+	hand.Cards = append(hand.Cards, s.draw(&state))
+	if value, _ := handValue(hand.Cards); value >= blackjackValue {
+		hand.Done = true
+	}
+	s.advance(&state)
+	return s.commit(state)
+}
+
+// Stand заканчивает ход по текущей руке
+func (s *serv) Stand(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, hand, err := s.playerTurn()
+	if err != nil {
+		return nil, err
+	}
+	hand.Done = true
+	s.advance(&state)
+	return s.commit(state)
+}
+
+// Double удваивает ставку на руке из двух карт, выдаёт ровно одну карту и заканчивает ход
+func (s *serv) Double(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, hand, err := s.playerTurn()
+	if err != nil {
+		return nil, err
+	}
+	if !s.canDouble(*hand) {
+		return nil, errors.New("double is not allowed on this hand")
+	}
+	if _, err := s.wallet.Debit(hand.Bet); err != nil {
+		return nil, err
+	}
+
+	hand.Bet *= 2
+	hand.Doubled = true
+	hand.Cards = append(hand.Cards, s.draw(&state))
+	hand.Done = true
+	s.advance(&state)
+	return s.commit(state)
+}
+
+// Split разбивает пару на две руки с такой же ставкой.
+// Каждая рука добирает вторую карту; разбитые тузы получают по одной карте и больше не ходят.
+func (s *serv) Split(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, hand, err := s.playerTurn()
+	if err != nil {
+		return nil, err
+	}
+	if !s.canSplit(state.Round, *hand) {
+		return nil, errors.New("split is not allowed on this hand")
+	}
+	if _, err := s.wallet.Debit(hand.Bet); err != nil {
+		return nil, err
+	}
+
+	round := &state.Round
+	aces := hand.Cards[0].Rank == model.RankAce
+	first := model.BlackjackHand{Cards: hand.Cards[:1], Bet: hand.Bet, Split: true}
+	second := model.BlackjackHand{Cards: hand.Cards[1:], Bet: hand.Bet, Split: true}
+	for _, h := range []*model.BlackjackHand{&first, &second} {
+		h.Cards = append([]model.Card{}, h.Cards...)
+		h.Cards = append(h.Cards, s.draw(&state))
+		value, _ := handValue(h.Cards)
+		h.Done = aces || value == blackjackValue
+	}
+
+	hands := append([]model.BlackjackHand{}, round.Hands[:round.Current]...)
+	hands = append(hands, first, second)
+	round.Hands = append(hands, round.Hands[round.Current+1:]...)
+	s.advance(&state)
+	return s.commit(state)
+}
+
+// Surrender сдаётся первым решением по исходной руке: возвращается половина ставки
+func (s *serv) Surrender(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, hand, err := s.playerTurn()
+	if err != nil {
+		return nil, err
+	}
+	if !s.canSurrender(state.Round) {
+		return nil, errors.New("surrender is not allowed now")
+	}
+
+	hand.Result = model.BlackjackResultSurrender
+	hand.Done = true
+	s.advance(&state)
+	return s.commit(state)
+}
+
+// playerTurn загружает раунд и возвращает руку, по которой сейчас ход
+func (s *serv) playerTurn() (model.BlackjackState, *model.BlackjackHand, error) {
+	state, err := s.repo.GetState()
+	if err != nil {
+		return state, nil, errors.New("failed to get blackjack state")
+	}
+	switch state.Round.Phase {
+	case model.BlackjackPhasePlayer:
+		return state, &state.Round.Hands[state.Round.Current], nil
+	case model.BlackjackPhaseInsurance:
+		return state, nil, errors.New("decide on insurance first")
+	default:
+		return state, nil, errors.New("no hand in progress: deal first")
+	}
+}
+
+// advance переходит к следующей несыгранной руке; когда рук не осталось — ход дилера и расчёт
+func (s *serv) advance(state *model.BlackjackState) {
+	round := &state.Round
+	for round.Current < len(round.Hands) && round.Hands[round.Current].Done {
+		round.Current++
+	}
+	if round.Current == len(round.Hands) {
+		round.Current = len(round.Hands) - 1
+		s.finish(state)
+	}
+}
+
+func (s *serv) canDouble(hand model.BlackjackHand) bool {
+	return len(hand.Cards) == 2 && (!hand.Split || s.cfg.DoubleAfterSplit())
+}
+
+// canSplit пара одинаковых по очкам карт (10 и король тоже пара), пока не исчерпан лимит рук
+func (s *serv) canSplit(round model.BlackjackRound, hand model.BlackjackHand) bool {
+	return len(hand.Cards) == 2 &&
+		cardValue(hand.Cards[0]) == cardValue(hand.Cards[1]) &&
+		len(round.Hands) < s.cfg.MaxHands()
+}
+
+func (s *serv) canSurrender(round model.BlackjackRound) bool {
+	return s.cfg.Surrender() && len(round.Hands) == 1 && len(round.Hands[0].Cards) == 2
+}
@@ -0,0 +1,64 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/cards"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Deal списывает ставку и раздаёт по две карты игроку и дилеру.
+// При тузе у дилера сначала предлагается страховка, иначе сразу проверяются блэкджеки.
+func (s *serv) Deal(ctx context.Context, bet int) (*model.BlackjackTable, error) {
+	if bet < s.cfg.MinBet() || bet > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get blackjack state")
+	}
+	if isActive(state.Round) {
+		return nil, errors.New("hand is in progress: finish it first")
+	}
+
+	if _, err := s.wallet.Debit(bet); err != nil {
+		return nil, err
+	}
+
+	if s.needsShuffle(state.Shoe) {
+		state.Shoe = cards.Shuffled(s.rng, s.cfg.Decks())
+	}
+	hand := model.BlackjackHand{Bet: bet}
+	var dealer []model.Card
+	for i := 0; i < 2; i++ {
+		hand.Cards = append(hand.Cards, s.draw(&state))
+		dealer = append(dealer, s.draw(&state))
+	}
+	state.Round = model.BlackjackRound{
+		Phase:  model.BlackjackPhasePlayer,
+		Bet:    bet,
+		Hands:  []model.BlackjackHand{hand},
+		Dealer: dealer,
+	}
+
+	if dealer[0].Rank == model.RankAce {
+		state.Round.Phase = model.BlackjackPhaseInsurance
+	} else {
+		s.checkNaturals(&state)
+	}
+	return s.commit(state)
+}
+
+// checkNaturals дилер заглядывает под карту: при блэкджеке у дилера или игрока раунд сразу рассчитывается
+func (s *serv) checkNaturals(state *model.BlackjackState) {
+	round := &state.Round
+	if isBlackjack(round.Dealer, false) || isBlackjack(round.Hands[0].Cards, false) {
+		round.Hands[0].Done = true
+		s.finish(state)
+	}
+}
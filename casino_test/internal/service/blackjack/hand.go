@@ -0,0 +1,44 @@
+package blackjack
+
+import "casino_test/internal/model"
+
+const blackjackValue = 21
+
+// cardValue очки карты: картинки — 10, туз — 11 (понижается до 1 в handValue)
+func cardValue(card model.Card) int {
+	switch {
+	case card.Rank == model.RankAce:
+		return 11
+	case card.Rank >= 10:
+		return 10
+	default:
+		return card.Rank
+	}
+}
+
+// handValue сумма очков и признак мягкой руки (туз всё ещё считается за 11)
+func handValue(cards []model.Card) (int, bool) {
+	total, aces := 0, 0
+	for _, card := range cards {
+		total += cardValue(card)
+		if card.Rank == model.RankAce {
+			aces++
+		}
+	}
+	for total > blackjackValue && aces > 0 {
+		total -= 10
+		aces--
+	}
+	return total, aces > 0
+}
+
+// isBlackjack 21 на двух картах; после сплита это просто 21
+func isBlackjack(cards []model.Card, split bool) bool {
+	value, _ := handValue(cards)
+	return !split && len(cards) == 2 && value == blackjackValue
+}
+
+// isActive идёт ли раунд (ждёт действий игрока)
+func isActive(round model.BlackjackRound) bool {
+	return round.Phase == model.BlackjackPhaseInsurance || round.Phase == model.BlackjackPhasePlayer
+}
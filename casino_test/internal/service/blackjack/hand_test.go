@@ -0,0 +1,64 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"testing"
+)
+
+// ranks карты нужных рангов; масть на очки не влияет
+func ranks(values ...int) []model.Card {
+	result := make([]model.Card, len(values))
+	for i, rank := range values {
+		result[i] = model.Card{Rank: rank, Suit: model.SuitSpades}
+	}
+	return result
+}
+
+func TestHandValue(t *testing.T) {
+	const ace, king, queen = model.RankAce, model.RankKing, model.RankQueen
+	tests := []struct {
+		name      string
+		cards     []model.Card
+		wantValue int
+		wantSoft  bool
+	}{
+		{"hard total", ranks(10, 7), 17, false},
+		{"pictures count ten", ranks(king, queen), 20, false},
+		{"soft ace", ranks(ace, 6), 17, true},
+		{"ace drops to one", ranks(ace, 6, 10), 17, false},
+		{"two aces", ranks(ace, ace), 12, true},
+		{"two aces and nine", ranks(ace, ace, 9), 21, true},
+		{"bust", ranks(king, 7, 5), 22, false},
+		{"blackjack", ranks(ace, king), 21, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, soft := handValue(tt.cards)
+			if value != tt.wantValue || soft != tt.wantSoft {
+				t.Errorf("handValue(%v) = %d, %v, want %d, %v", tt.cards, value, soft, tt.wantValue, tt.wantSoft)
+			}
+		})
+	}
+}
+
+func TestIsBlackjack(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards []model.Card
+		split bool
+		want  bool
+	}{
+		{"ace and ten", ranks(model.RankAce, 10), false, true},
+		{"ace and king", ranks(model.RankKing, model.RankAce), false, true},
+		{"21 after split", ranks(model.RankAce, 10), true, false},
+		{"21 on three cards", ranks(7, 7, 7), false, false},
+		{"20", ranks(10, 10), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isBlackjack(tt.cards, tt.split); got != tt.want {
+				t.Errorf("isBlackjack(%v, %v) = %v, want %v", tt.cards, tt.split, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Insurance принимает решение по страховке, когда у дилера открыт туз.
+// Страховка стоит половину ставки и платит 2:1, если у дилера блэкджек.
+func (s *serv) Insurance(ctx context.Context, take bool) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get blackjack state")
+	}
+	if state.Round.Phase != model.BlackjackPhaseInsurance {
+		return nil, errors.New("insurance is not offered now")
+	}
+
+	if take {
+		amount := state.Round.Bet / 2
+		if amount == 0 {
+			return nil, errors.New("bet is too small to insure")
+		}
+		if _, err := s.wallet.Debit(amount); err != nil {
+			return nil, err
+		}
+		state.Round.Insurance = amount
+	}
+
+	state.Round.Phase = model.BlackjackPhasePlayer
+	s.checkNaturals(&state)
+	return s.commit(state)
+}
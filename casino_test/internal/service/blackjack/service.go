@@ -0,0 +1,29 @@
+package blackjack
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"sync"
+)
+
+type serv struct {
+	cfg    config.BlackjackConfig
+	repo   repository.BlackjackRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+
+	// Каждое действие читает раунд, двигает кошелёк и сохраняет раунд целиком под этим замком
+	mtx sync.Mutex
+}
+
+// NewBlackjackService Создать стол блэкджека на одного игрока
+func NewBlackjackService(cfg config.BlackjackConfig, repo repository.BlackjackRepository, wallet repository.WalletRepository, r rng.RNG) service.BlackjackService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
@@ -0,0 +1,100 @@
+package blackjack
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"errors"
+)
+
+// Во сколько раз страховка оплачивается вместе со ставкой (2:1)
+const insuranceReturn = 3
+
+// finish доигрывает за дилера и рассчитывает все руки.
+// Дилер добирает карты, только если осталась рука, которую нужно обыграть.
+func (s *serv) finish(state *model.BlackjackState) {
+	round := &state.Round
+	dealerBJ := isBlackjack(round.Dealer, false)
+
+	if !dealerBJ && s.dealerMustPlay(*round) {
+		for s.dealerHits(round.Dealer) {
+			round.Dealer = append(round.Dealer, s.draw(state))
+		}
+	}
+	dealerValue, _ := handValue(round.Dealer)
+
+	round.TotalPayout = 0
+	for i := range round.Hands {
+		hand := &round.Hands[i]
+		value, _ := handValue(hand.Cards)
+		playerBJ := isBlackjack(hand.Cards, hand.Split)
+
+		switch {
+		case hand.Result == model.BlackjackResultSurrender:
+			hand.Payout = hand.Bet / 2
+		case value > blackjackValue:
+			hand.Result = model.BlackjackResultBust
+		case playerBJ && dealerBJ:
+			hand.Result = model.BlackjackResultPush
+			hand.Payout = hand.Bet
+		case playerBJ:
+			hand.Result = model.BlackjackResultBlackjack
+			hand.Payout = hand.Bet + s.blackjackWin(hand.Bet)
+		case dealerBJ:
+			hand.Result = model.BlackjackResultLose
+		case dealerValue > blackjackValue || value > dealerValue:
+			hand.Result = model.BlackjackResultWin
+			hand.Payout = hand.Bet * 2
+		case value == dealerValue:
+			hand.Result = model.BlackjackResultPush
+			hand.Payout = hand.Bet
+		default:
+			hand.Result = model.BlackjackResultLose
+		}
+		round.TotalPayout += hand.Payout
+	}
+
+	if dealerBJ {
+		round.InsurancePayout = round.Insurance * insuranceReturn
+		round.TotalPayout += round.InsurancePayout
+	}
+	round.Phase = model.BlackjackPhaseFinished
+}
+
+// dealerMustPlay есть ли рука, исход которой зависит от карт дилера
+func (s *serv) dealerMustPlay(round model.BlackjackRound) bool {
+	for _, hand := range round.Hands {
+		value, _ := handValue(hand.Cards)
+		if hand.Result != model.BlackjackResultSurrender && value <= blackjackValue && !isBlackjack(hand.Cards, hand.Split) {
+			return true
+		}
+	}
+	return false
+}
+
+// dealerHits берёт ли дилер ещё карту: до 17, а на мягких 17 — по правилу стола
+func (s *serv) dealerHits(dealer []model.Card) bool {
+	value, soft := handValue(dealer)
+	return value < 17 || (value == 17 && soft && s.cfg.DealerHitsSoft17())
+}
+
+// blackjackWin выигрыш за блэкджек сверх ставки (округление вниз)
+func (s *serv) blackjackWin(bet int) int {
+	if s.cfg.BlackjackPays() == config.BlackjackPays6To5 {
+		return bet * 6 / 5
+	}
+	return bet * 3 / 2
+}
+
+// commit зачисляет выплату, если действие закончило раунд, и сохраняет стол.
+// Действия принимаются только в идущем раунде, так что расчёт по раунду зачисляется ровно один раз.
+func (s *serv) commit(state model.BlackjackState) (*model.BlackjackTable, error) {
+	if state.Round.Phase == model.BlackjackPhaseFinished && state.Round.TotalPayout > 0 {
+		if _, err := s.wallet.Credit(state.Round.TotalPayout); err != nil {
+			return nil, errors.New("failed to update user balance")
+		}
+	}
+	if err := s.repo.UpdateState(state); err != nil {
+		return nil, errors.New("failed to save blackjack state")
+	}
+	return s.table(state)
+}
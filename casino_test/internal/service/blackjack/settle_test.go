@@ -0,0 +1,82 @@
+package blackjack
+
+import (
+	"casino_test/internal/config/env"
+	"casino_test/internal/model"
+	"casino_test/pkg/rng"
+	"testing"
+)
+
+// newTestServ стол по правилам из config.yaml: S17, блэкджек 3:2
+func newTestServ(t *testing.T) *serv {
+	t.Helper()
+	cfg, err := env.NewBlackjackConfigFromYAML("../../../config.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &serv{cfg: cfg, rng: rng.NewSeeded(1)}
+}
+
+func TestFinish(t *testing.T) {
+	const ace, king = model.RankAce, model.RankKing
+	tests := []struct {
+		name       string
+		hand       model.BlackjackHand
+		dealer     []model.Card
+		shoe       []model.Card // Карты, которые доберёт дилер
+		insurance  int
+		wantResult string
+		wantPayout int
+		wantDealer int // Сколько карт у дилера в конце
+	}{
+		{"win against dealer standing", model.BlackjackHand{Cards: ranks(10, 9), Bet: 10}, ranks(10, 8), nil, 0, model.BlackjackResultWin, 20, 2},
+		{"lose against dealer standing", model.BlackjackHand{Cards: ranks(10, 7), Bet: 10}, ranks(10, 8), nil, 0, model.BlackjackResultLose, 0, 2},
+		{"push", model.BlackjackHand{Cards: ranks(10, 8), Bet: 10}, ranks(king, 8), nil, 0, model.BlackjackResultPush, 10, 2},
+		{"dealer draws and busts", model.BlackjackHand{Cards: ranks(10, 2), Bet: 10}, ranks(10, 6), ranks(king), 0, model.BlackjackResultWin, 20, 3},
+		{"dealer draws to 21", model.BlackjackHand{Cards: ranks(10, 10), Bet: 10}, ranks(5, 6), ranks(king), 0, model.BlackjackResultLose, 0, 3},
+		{"dealer stands on soft 17", model.BlackjackHand{Cards: ranks(10, 8), Bet: 10}, ranks(ace, 6), ranks(king), 0, model.BlackjackResultWin, 20, 2},
+		{"player bust loses even if dealer busts", model.BlackjackHand{Cards: ranks(10, 6, king), Bet: 10}, ranks(10, 6), ranks(king), 0, model.BlackjackResultBust, 0, 2},
+		{"blackjack pays 3:2", model.BlackjackHand{Cards: ranks(ace, king), Bet: 10}, ranks(10, 9), nil, 0, model.BlackjackResultBlackjack, 25, 2},
+		{"blackjack pays 3:2 rounded down", model.BlackjackHand{Cards: ranks(ace, king), Bet: 5}, ranks(10, 9), nil, 0, model.BlackjackResultBlackjack, 12, 2},
+		{"blackjack against blackjack pushes", model.BlackjackHand{Cards: ranks(ace, king), Bet: 10}, ranks(ace, 10), nil, 0, model.BlackjackResultPush, 10, 2},
+		{"split 21 is not blackjack", model.BlackjackHand{Cards: ranks(ace, king), Bet: 10, Split: true}, ranks(10, 9), nil, 0, model.BlackjackResultWin, 20, 2},
+		{"dealer blackjack beats 21", model.BlackjackHand{Cards: ranks(7, 7, 7), Bet: 10}, ranks(ace, king), nil, 0, model.BlackjackResultLose, 0, 2},
+		{"insurance pays 2:1", model.BlackjackHand{Cards: ranks(10, 9), Bet: 10}, ranks(ace, king), nil, 5, model.BlackjackResultLose, 0, 2},
+		{"surrender returns half", model.BlackjackHand{Cards: ranks(10, 6), Bet: 10, Result: model.BlackjackResultSurrender}, ranks(10, 9), ranks(king), 0, model.BlackjackResultSurrender, 5, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServ(t)
+			state := model.BlackjackState{
+				Shoe: tt.shoe,
+				Round: model.BlackjackRound{
+					Phase:     model.BlackjackPhasePlayer,
+					Bet:       tt.hand.Bet,
+					Hands:     []model.BlackjackHand{tt.hand},
+					Dealer:    tt.dealer,
+					Insurance: tt.insurance,
+				},
+			}
+			s.finish(&state)
+
+			round := state.Round
+			hand := round.Hands[0]
+			if hand.Result != tt.wantResult || hand.Payout != tt.wantPayout {
+				t.Errorf("hand = %s paying %d, want %s paying %d", hand.Result, hand.Payout, tt.wantResult, tt.wantPayout)
+			}
+			if len(round.Dealer) != tt.wantDealer {
+				t.Errorf("dealer has %d cards, want %d", len(round.Dealer), tt.wantDealer)
+			}
+			wantInsurance := 0
+			if isBlackjack(tt.dealer, false) {
+				wantInsurance = tt.insurance * insuranceReturn
+			}
+			if round.InsurancePayout != wantInsurance || round.TotalPayout != tt.wantPayout+wantInsurance {
+				t.Errorf("insurance %d, total %d, want %d, %d", round.InsurancePayout, round.TotalPayout, wantInsurance, tt.wantPayout+wantInsurance)
+			}
+			if round.Phase != model.BlackjackPhaseFinished {
+				t.Errorf("phase = %s, want %s", round.Phase, model.BlackjackPhaseFinished)
+			}
+		})
+	}
+}
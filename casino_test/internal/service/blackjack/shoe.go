@@ -0,0 +1,23 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/cards"
+)
+
+// needsShuffle пора ли перемешать шуз: роздано больше точки перемешивания
+func (s *serv) needsShuffle(shoe []model.Card) bool {
+	total := s.cfg.Decks() * 52
+	dealt := total - len(shoe)
+	return float64(dealt) >= float64(total)*s.cfg.ShufflePoint()
+}
+
+// draw выдаёт верхнюю карту шуза. Если шуз кончился посреди раунда, берётся свежий.
+func (s *serv) draw(state *model.BlackjackState) model.Card {
+	if len(state.Shoe) == 0 {
+		state.Shoe = cards.Shuffled(s.rng, s.cfg.Decks())
+	}
+	card := state.Shoe[0]
+	state.Shoe = state.Shoe[1:]
+	return card
+}
@@ -0,0 +1,69 @@
+package blackjack
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// State возвращает стол, чтобы интерфейс мог продолжить раздачу после перезагрузки
+func (s *serv) State(ctx context.Context) (*model.BlackjackTable, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get blackjack state")
+	}
+	return s.table(state)
+}
+
+// table собирает стол для клиента: очки рук, доступные действия; скрытая карта дилера убирается до конца раунда
+func (s *serv) table(state model.BlackjackState) (*model.BlackjackTable, error) {
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, errors.New("failed to get user balance")
+	}
+	table := &model.BlackjackTable{
+		Actions:  s.actions(state.Round),
+		ShoeLeft: len(state.Shoe),
+		Balance:  balance,
+	}
+	if state.Round.Phase == "" {
+		return table, nil
+	}
+
+	round := state.Round
+	for i := range round.Hands {
+		round.Hands[i].Value, round.Hands[i].Soft = handValue(round.Hands[i].Cards)
+	}
+	if isActive(round) {
+		round.Dealer = round.Dealer[:1]
+	}
+	table.DealerValue, _ = handValue(round.Dealer)
+	table.Round = &round
+	return table, nil
+}
+
+// actions действия, доступные игроку сейчас
+func (s *serv) actions(round model.BlackjackRound) []string {
+	switch round.Phase {
+	case model.BlackjackPhaseInsurance:
+		return []string{model.BlackjackActionInsurance}
+	case model.BlackjackPhasePlayer:
+		hand := round.Hands[round.Current]
+		actions := []string{model.BlackjackActionHit, model.BlackjackActionStand}
+		if s.canDouble(hand) {
+			actions = append(actions, model.BlackjackActionDouble)
+		}
+		if s.canSplit(round, hand) {
+			actions = append(actions, model.BlackjackActionSplit)
+		}
+		if s.canSurrender(round) {
+			actions = append(actions, model.BlackjackActionSurrender)
+		}
+		return actions
+	default:
+		return []string{model.BlackjackActionDeal}
+	}
+}
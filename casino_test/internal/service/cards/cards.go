@@ -0,0 +1,31 @@
+// Package cards колоды для карточных игр
+package cards
+
+import (
+	"casino_test/internal/model"
+	"casino_test/pkg/rng"
+)
+
+var suits = []string{model.SuitHearts, model.SuitDiamonds, model.SuitClubs, model.SuitSpades}
+
+// NewDeck собирает decks колод по 52 карты, не перемешивая
+func NewDeck(decks int) []model.Card {
+	deck := make([]model.Card, 0, decks*52)
+	for d := 0; d < decks; d++ {
+		for _, suit := range suits {
+			for rank := 2; rank <= model.RankAce; rank++ {
+				deck = append(deck, model.Card{Rank: rank, Suit: suit})
+			}
+		}
+	}
+	return deck
+}
+
+// Shuffled возвращает перемешанный шуз из decks колод
+func Shuffled(r rng.RNG, decks int) []model.Card {
+	deck := NewDeck(decks)
+	r.Shuffle(len(deck), func(i, j int) {
+		deck[i], deck[j] = deck[j], deck[i]
+	})
+	return deck
+}
@@ -1,27 +1,82 @@
 package cascade
 
 import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
 	"errors"
 )
 
-// Купить бонуску
-func (s *serv) BuyBonus(amount int) error {
-	cost := amount
+// Купить бонуску выбранного продукта
+func (s *serv) BuyBonus(req model.CascadeBuyBonus) (*model.CascadeBuyBonusResult, error) {
+	if req.Bet <= 0 || req.Bet%2 != 0 {
+		return nil, errors.New("bet must be positive and even")
+	}
+	if !s.betCfg.IsAllowedBet(req.Bet) {
+		return nil, errors.New("bet is not on the bet ladder")
+	}
+
+	if err := s.checkNoActiveWheel(); err != nil {
+		return nil, err
+	}
+
+	product, ok := s.findBonusBuy(req.ProductID)
+	if !ok {
+		return nil, errors.New("unknown bonus buy product")
+	}
+
+	freeSpins, err := s.repo.GetFreeSpinCount()
+	if err != nil {
+		return nil, errors.New("failed to get count free spins")
+	}
+	if freeSpins > 0 {
+		return nil, errors.New("bonus buy is not available during free spins")
+	}
 
-	balance, err := s.repo.GetBalance()
+	cost := product.PriceXBet * req.Bet
+
+	balance, err := s.wallet.Debit(cost)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.UpdateFreeSpinCount(product.FreeSpins)
+	if err != nil {
+		return nil, errors.New("failed to update free spin count after bonus buy")
+	}
+	// Купленный бонус — новый раунд, лимит выигрыша считается с нуля
+	err = s.repo.UpdateFeatureWin(0)
 	if err != nil {
-		return errors.New("failed to get user balance")
+		return nil, errors.New("failed to reset feature win after bonus buy")
 	}
-	if balance < cost {
-		return errors.New("not enough balance for bonus buy")
+	// Фриспины купленного бонуса играются на ставку покупки
+	err = s.repo.UpdateFeatureBet(req.Bet)
+	if err != nil {
+		return nil, errors.New("failed to update feature bet after bonus buy")
 	}
-	err = s.repo.UpdateBalance(balance - cost)
+	// Поле множителей заранее заполняется стартовым значением продукта
+	err = s.repo.UpdateFeatureStartMultiplier(product.StartMultiplier)
 	if err != nil {
-		return errors.New("failed to update balance after bonus buy")
+		return nil, errors.New("failed to update feature multiplier after bonus buy")
 	}
-	err = s.repo.UpdateFreeSpinCount(10)
+	err = s.repo.ResetMultiplierState(product.StartMultiplier)
 	if err != nil {
-		return errors.New("failed to update free spin count after bonus buy")
+		return nil, errors.New("failed to seed multipliers after bonus buy")
+	}
+
+	return &model.CascadeBuyBonusResult{
+		ProductID:     product.ID,
+		Cost:          cost,
+		AwardedSpins:  product.FreeSpins,
+		Balance:       balance,
+		FreeSpinsLeft: product.FreeSpins,
+	}, nil
+}
+
+// findBonusBuy ищет продукт покупки бонуса по ID
+func (s *serv) findBonusBuy(id string) (config.CascadeBonusBuy, bool) {
+	for _, buy := range s.cfg.BonusBuys() {
+		if buy.ID == id {
+			return buy, true
+		}
 	}
-	return nil
+	return config.CascadeBonusBuy{}, false
 }
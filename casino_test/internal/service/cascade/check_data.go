@@ -1,9 +1,12 @@
 package cascade
 
-import "casino_test/internal/model"
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/wheel"
+)
 
 func (s *serv) CheckData() (*model.CascadeData, error) {
-	balance, err := s.repo.GetBalance()
+	balance, err := s.wallet.GetBalance()
 	if err != nil {
 		return nil, err
 	}
@@ -11,8 +14,21 @@ func (s *serv) CheckData() (*model.CascadeData, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &model.CascadeData{
+	data := &model.CascadeData{
 		Balance:       balance, // Используем экспортированные имена
 		FreeSpinCount: freeSpins,
-	}, nil
+	}
+
+	wheelState, err := s.repo.GetWheelState()
+	if err != nil {
+		return nil, err
+	}
+	if wheelState.Active {
+		view, err := wheel.View(s.wheelCfg, wheelState)
+		if err != nil {
+			return nil, err
+		}
+		data.Wheel = &view
+	}
+	return data, nil
 }
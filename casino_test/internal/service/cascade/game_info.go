@@ -0,0 +1,23 @@
+package cascade
+
+import "casino_test/internal/model"
+
+// GameInfo возвращает статические параметры игры: продукты покупки бонуса и лимит выигрыша
+func (s *serv) GameInfo() (*model.CascadeGameInfo, error) {
+	buys := s.cfg.BonusBuys()
+	products := make([]model.CascadeBonusBuyProduct, len(buys))
+	for i, buy := range buys {
+		products[i] = model.CascadeBonusBuyProduct{
+			ID:              buy.ID,
+			Name:            buy.Name,
+			PriceXBet:       buy.PriceXBet,
+			FreeSpins:       buy.FreeSpins,
+			StartMultiplier: buy.StartMultiplier,
+		}
+	}
+
+	return &model.CascadeGameInfo{
+		BonusBuys:  products,
+		MaxWinXBet: s.cfg.MaxWinXBet(),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package cascade
+
+import "casino_test/internal/config"
+
+// nextMultiplier возвращает множитель ячейки после очередного попадания в кластер.
+// Первое попадание только отмечает ячейку, второе выставляет стартовый множитель,
+// каждое следующее наращивает его по правилу из конфига (но не выше максимума).
+func (s *serv) nextMultiplier(hits, current int) int {
+	if hits < 2 {
+		return current
+	}
+
+	start := s.cfg.MultiplierStart()
+	maxMult := s.cfg.MultiplierMax()
+	if hits == 2 || current < start {
+		return min(start, maxMult)
+	}
+
+	var next int
+	switch s.cfg.MultiplierGrowth() {
+	case config.MultiplierGrowthAdd:
+		next = current + s.cfg.MultiplierStep()
+	default:
+		next = current * 2
+	}
+	if next > maxMult {
+		next = maxMult
+	}
+	return next
+}
+
+// clusterMultiplier объединяет множители ячеек кластера по правилу из конфига
+func (s *serv) clusterMultiplier(cl cluster, mult [rows][cols]int) int {
+	length := len(cl.cells)
+	if length == 0 {
+		return 1
+	}
+
+	var result int
+	switch s.cfg.MultiplierCombine() {
+	case config.MultiplierCombineSum:
+		// Суммируем только отмеченные множителем ячейки (x1 не считается)
+		for _, cell := range cl.cells {
+			if m := mult[cell[0]][cell[1]]; m > 1 {
+				result += m
+			}
+		}
+	case config.MultiplierCombineProduct:
+		result = 1
+		for _, cell := range cl.cells {
+			if m := mult[cell[0]][cell[1]]; m > 1 {
+				result *= m
+			}
+			// Выше лимита выигрыша множитель всё равно не даст — защищаемся от переполнения int
+			if result > s.cfg.MaxWinXBet() {
+				result = s.cfg.MaxWinXBet()
+				break
+			}
+		}
+	default:
+		// Средний множитель (округление вниз — как в оригинале)
+		var sum int
+		for _, cell := range cl.cells {
+			sum += mult[cell[0]][cell[1]]
+		}
+		result = sum / length
+	}
+
+	if result < 1 {
+		result = 1
+	}
+	return result
+}
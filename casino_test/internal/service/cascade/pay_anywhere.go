@@ -0,0 +1,27 @@
+package cascade
+
+// findPayAnywhere ищет выигрыши в режиме pay anywhere:
+// символ платит, если на поле его не меньше PayAnywhereMinCount штук в любых позициях.
+// Все ячейки символа уходят одной группой, дальше работают те же каскады и множители.
+func (s *serv) findPayAnywhere(board [rows][cols]int) []cluster {
+	cells := map[int][][2]int{}
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			sym := board[r][c]
+			if sym == emptyCell || sym == symbolBonus {
+				continue
+			}
+			cells[sym] = append(cells[sym], [2]int{r, c})
+		}
+	}
+
+	minCount := s.cfg.PayAnywhereMinCount()
+	var clusters []cluster
+	// Обходим символы по порядку, чтобы шаги каскада были детерминированы
+	for sym := 0; sym < symbolBonus; sym++ {
+		if len(cells[sym]) >= minCount {
+			clusters = append(clusters, cluster{symbol: sym, cells: cells[sym]})
+		}
+	}
+	return clusters
+}
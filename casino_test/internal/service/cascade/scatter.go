@@ -0,0 +1,72 @@
+package cascade
+
+import "casino_test/pkg/rng"
+
+// scatterPlacer расставляет бонусные символы с учётом лимитов на колонку и на всё поле.
+// Вероятность появления бонуса в колонке своя для базовой игры и для фриспинов.
+type scatterPlacer struct {
+	rng       rng.RNG
+	prob      float64
+	maxColumn int
+	maxBoard  int // 0 — без ограничения на поле
+	perColumn [cols]int
+	total     int
+}
+
+// newScatterPlacer создаёт расстановщик и учитывает бонусы, которые уже лежат на доске
+func (s *serv) newScatterPlacer(board [rows][cols]int, freeSpin bool) *scatterPlacer {
+	p := &scatterPlacer{
+		rng:       s.rng,
+		prob:      s.cfg.BonusProbPerColumn(),
+		maxColumn: s.cfg.BonusMaxPerColumn(),
+		maxBoard:  s.cfg.BonusMaxPerBoard(),
+	}
+	if freeSpin {
+		p.prob = s.cfg.BonusProbPerColumnFreeSpins()
+	}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			if board[r][c] == symbolBonus {
+				p.perColumn[c]++
+				p.total++
+			}
+		}
+	}
+	return p
+}
+
+// place пробует поставить бонусы в пустые ячейки колонки c.
+// На каждый свободный «слот» по лимиту — отдельный бросок, до первой неудачи.
+func (p *scatterPlacer) place(board *[rows][cols]int, c int) {
+	for p.canPlace(c) {
+		if p.rng.Float64() >= p.prob {
+			return
+		}
+
+		var empty []int
+		for r := 0; r < rows; r++ {
+			if board[r][c] == emptyCell {
+				empty = append(empty, r)
+			}
+		}
+		if len(empty) == 0 {
+			return
+		}
+
+		board[empty[p.rng.Intn(len(empty))]][c] = symbolBonus
+		p.perColumn[c]++
+		p.total++
+	}
+}
+
+// canPlace проверяет, не исчерпаны ли лимиты для колонки и поля
+func (p *scatterPlacer) canPlace(c int) bool {
+	if p.perColumn[c] >= p.maxColumn {
+		return false
+	}
+	if p.maxBoard > 0 && p.total >= p.maxBoard {
+		return false
+	}
+	return true
+}
@@ -4,17 +4,28 @@ import (
 	"casino_test/internal/config"
 	"casino_test/internal/repository"
 	"casino_test/internal/service"
+	"casino_test/pkg/rng"
 )
 
 type serv struct {
-	cfg  config.CascadeConfig
-	repo repository.CascadeRepository
+	cfg      config.CascadeConfig
+	wheelCfg config.WheelConfig
+	betCfg   config.BetConfig
+	jackpots service.JackpotService
+	repo     repository.CascadeRepository
+	wallet   repository.WalletRepository
+	rng      rng.RNG
 }
 
 // NewCascade Создать новый cascade
-func NewCascadeService(cfg config.CascadeConfig, repo repository.CascadeRepository) service.CascadeService {
+func NewCascadeService(cfg config.CascadeConfig, wheelCfg config.WheelConfig, betCfg config.BetConfig, repo repository.CascadeRepository, wallet repository.WalletRepository, jackpots service.JackpotService, r rng.RNG) service.CascadeService {
 	return &serv{
-		cfg:  cfg,
-		repo: repo,
+		cfg:      cfg,
+		wheelCfg: wheelCfg,
+		betCfg:   betCfg,
+		repo:     repo,
+		wallet:   wallet,
+		jackpots: jackpots,
+		rng:      r,
 	}
 }
@@ -4,8 +4,9 @@ import (
 	"context"
 	"errors"
 	"log"
-	"math/rand"
+	"sort"
 
+	"casino_test/internal/config"
 	"casino_test/internal/model"
 )
 
@@ -22,23 +23,16 @@ const (
 	//minBetPower = 2
 	//maxBetPower = 10
 
-	// Множители на ячейках: при втором удалении x2, далее удваивается до MAX
-	multiplierStart = 2
-	multiplierMax   = 128 // До x128 — чтобы не переполнить int при умножении
-
 	// Предел итераций разрешения каскадов
 	maxResolveIter = 100
-
-	// Ограничение максимального выигрыша (в кратности ставки)
-	maxWinXBet = 10000
-
-	// Стоимость покупки бонуса в кратности ставки
-	bonusBuyMultiplier = 100
 )
 
 // Пустая ячейка
 const emptyCell = -1
 
+// GameID ID игры в реестре (по нему джекпоты выбирают порог скаттеров)
+const GameID = "cascade"
+
 type cluster struct {
 	symbol int
 	cells  [][2]int
@@ -49,6 +43,13 @@ func (s *serv) Spin(ctx context.Context, req model.CascadeSpin) (*model.CascadeS
 	if req.Bet <= 0 || req.Bet%2 != 0 {
 		return nil, errors.New("bet must be positive and even")
 	}
+	if !s.betCfg.IsAllowedBet(req.Bet) {
+		return nil, errors.New("bet is not on the bet ladder")
+	}
+
+	if err := s.checkNoActiveWheel(); err != nil {
+		return nil, err
+	}
 
 	freeSpins, err := s.repo.GetFreeSpinCount()
 	if err != nil {
@@ -56,18 +57,21 @@ func (s *serv) Spin(ctx context.Context, req model.CascadeSpin) (*model.CascadeS
 	}
 
 	isFreeSpin := freeSpins > 0
-	var balance int
+	// Выигрыш, уже набранный в текущем бонусе (лимит действует на весь бонус целиком)
+	var featureWin int
+	// Фриспины ничего не списывают, поэтому платят от ставки, с которой начался бонус
+	bet := req.Bet
 
 	if !isFreeSpin {
-		balance, err = s.repo.GetBalance()
-		if err != nil {
+		// Кошелёк общий для всех игр — списываем атомарно
+		if _, err := s.wallet.Debit(req.Bet); err != nil {
 			return nil, err
 		}
-		if balance < req.Bet {
-			return nil, errors.New("not enough balance")
+		// Бонус, выигранный в платном спине, начинается с чистого поля множителей
+		if err := s.repo.UpdateFeatureStartMultiplier(1); err != nil {
+			return nil, err
 		}
-		balance -= req.Bet
-		if err := s.repo.UpdateBalance(balance); err != nil {
+		if err := s.repo.UpdateFeatureBet(bet); err != nil {
 			return nil, err
 		}
 	} else {
@@ -75,28 +79,70 @@ func (s *serv) Spin(ctx context.Context, req model.CascadeSpin) (*model.CascadeS
 		if err := s.repo.UpdateFreeSpinCount(freeSpins); err != nil {
 			return nil, err
 		}
+		featureWin, err = s.repo.GetFeatureWin()
+		if err != nil {
+			return nil, err
+		}
+		bet, err = s.repo.GetFeatureBet()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	spinRes, err := s.spinOnce(req.Bet, !isFreeSpin)
+	winCap := s.remainingWinCap(bet, featureWin)
+
+	// Множители сбрасываются на каждом платном спине, а во фриспинах — только если так задано в конфиге
+	resetMultipliers := !isFreeSpin || s.cfg.MultiplierResetEachFreeSpin()
+	spinRes, err := s.spinOnce(bet, isFreeSpin, resetMultipliers, winCap)
 	if err != nil {
 		return nil, err
 	}
 
+	// Платный спин пополняет джекпоты и может их выиграть (выигрыш сразу идёт в кошелёк)
+	jackpots := []model.JackpotWin{}
+	if !isFreeSpin {
+		jackpots, err = s.jackpots.Contribute(ctx, GameID, bet, spinRes.ScatterCount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Начисление выигрыша
-	balance, err = s.repo.GetBalance()
+	balance, err := s.wallet.Credit(spinRes.TotalPayout)
 	if err != nil {
 		return nil, err
 	}
-	balance += spinRes.TotalPayout
-	if err := s.repo.UpdateBalance(balance); err != nil {
+
+	if err := s.repo.UpdateFeatureWin(featureWin + spinRes.TotalPayout); err != nil {
+		return nil, err
+	}
+
+	// В режиме колеса бонусные символы запускают колесо вместо фриспинов
+	wheelView, err := s.startWheel(spinRes, bet)
+	if err != nil {
 		return nil, err
 	}
 
-	// Начисление фриспинов — уже сделано внутри spinOnce → просто читаем результат
 	finalFreeSpins, err := s.repo.GetFreeSpinCount()
 	if err != nil {
 		return nil, err
 	}
+	if spinRes.MaxWinReached {
+		// Лимит выигрыша достигнут — раунд окончен, оставшиеся фриспины сгорают
+		spinRes.AwardedFreeSpins = 0
+		finalFreeSpins = 0
+		if err := s.repo.UpdateFreeSpinCount(0); err != nil {
+			return nil, err
+		}
+		if err := s.repo.ResetMultiplierState(1); err != nil {
+			return nil, err
+		}
+	} else if spinRes.AwardedFreeSpins > 0 {
+		finalFreeSpins += spinRes.AwardedFreeSpins
+		if err := s.repo.UpdateFreeSpinCount(finalFreeSpins); err != nil {
+			return nil, err
+		}
+	}
 	spinRes.FreeSpinsLeft = finalFreeSpins
 
 	// Заполняем индексы каскадов (0 = первый)
@@ -114,11 +160,15 @@ func (s *serv) Spin(ctx context.Context, req model.CascadeSpin) (*model.CascadeS
 		AwardedFreeSpins: spinRes.AwardedFreeSpins,
 		FreeSpinsLeft:    spinRes.FreeSpinsLeft,
 		InFreeSpin:       isFreeSpin,
+		MaxWinReached:    spinRes.MaxWinReached,
+		Wheel:            wheelView,
+		Jackpots:         jackpots,
 	}, nil
 }
 
-// spinOnce полный спин с каскадами
-func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResult, error) {
+// spinOnce полный спин с каскадами.
+// winCap — сколько ещё можно выиграть; как только он набран, каскады останавливаются.
+func (s *serv) spinOnce(bet int, freeSpin, resetMultipliers bool, winCap int) (*model.CascadeSpinResult, error) {
 	var board [rows][cols]int
 	var hits, mult [rows][cols]int
 
@@ -126,21 +176,24 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 	mult, hits = s.repo.GetMultiplierState()
 
 	if resetMultipliers {
-		// Только при платном спине — полный сброс
-		if err := s.repo.ResetMultiplierState(); err != nil {
-			return nil, err
-		}
-		mult = [rows][cols]int{} // все 0 → потом станет 1
-		hits = [rows][cols]int{}
-		for i := range mult {
-			for j := range mult[i] {
-				mult[i][j] = 1
+		// Платный спин (или фриспин без сохранения множителей) — полный сброс.
+		// Во фриспинах сбрасываем к стартовому множителю бонуса (купленный бонус может начинаться с x2)
+		start := 1
+		if freeSpin {
+			var err error
+			start, err = s.repo.GetFeatureStartMultiplier()
+			if err != nil {
+				return nil, err
 			}
 		}
-		s.fillBoard(&board)
+		if err := s.repo.ResetMultiplierState(start); err != nil {
+			return nil, err
+		}
+		mult, hits = s.repo.GetMultiplierState()
+		s.fillBoard(&board, freeSpin)
 	} else {
 		// Фриспин — оставляем старые множители, но генерим новую доску
-		s.fillBoard(&board)
+		s.fillBoard(&board, freeSpin)
 		// ← Важно: множители остаются от прошлого спина!
 	}
 
@@ -149,9 +202,10 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 
 	cascades := []model.CascadeStep{}
 	var totalWin int
+	maxWinReached := false
 
 	for iter := 0; iter < maxResolveIter; iter++ {
-		clusters := s.findClusters(board)
+		clusters := s.findWins(board)
 		if len(clusters) == 0 {
 			break
 		}
@@ -161,8 +215,13 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 
 		for _, cl := range clusters {
 			win := s.calculateWin(cl, mult, bet)
+			if win > 0 && totalWin+win >= winCap {
+				// Доплачиваем только до лимита, остальные кластеры шага уже не играют
+				win = winCap - totalWin
+				maxWinReached = true
+			}
 			totalWin += win
-			avgMult := s.averageMultiplier(cl, mult)
+			clMult := s.clusterMultiplier(cl, mult)
 
 			positions := make([]model.Position, len(cl.cells))
 			for i, cell := range cl.cells {
@@ -186,13 +245,16 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 				Cells:      positions,
 				Count:      len(cl.cells),
 				Payout:     win,
-				Multiplier: avgMult,
+				Multiplier: clMult,
 			})
 
 			s.removeCluster(cl, &board, &hits, &mult)
+			if maxWinReached {
+				break
+			}
 		}
 
-		s.collapseAndRefill(&board)
+		s.collapseAndRefill(&board, freeSpin)
 
 		// Новые символы
 		for r := 0; r < rows; r++ {
@@ -207,6 +269,9 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 		}
 
 		cascades = append(cascades, step)
+		if maxWinReached {
+			break
+		}
 	}
 
 	// ← Сохраняем обновлённое состояние множителей!
@@ -222,35 +287,39 @@ func (s *serv) spinOnce(bet int, resetMultipliers bool) (*model.CascadeSpinResul
 		}
 	}
 
-	totalPayout := s.applyMaxPayout(totalWin, bet)
-
 	return &model.CascadeSpinResult{
 		InitialBoard:     initialBoard,
 		Board:            board,
 		Cascades:         cascades,
-		TotalPayout:      totalPayout,
+		TotalPayout:      totalWin,
 		ScatterCount:     scatterCount,
 		AwardedFreeSpins: awarded,
+		MaxWinReached:    maxWinReached,
 	}, nil
 }
 
 //---------- ВСПОМОГАТЕЛЬНЫЕ МЕТОДЫ ----------
 
 // fillBoard заполняет доску начальными символами
-func (s *serv) fillBoard(board *[rows][cols]int) {
+func (s *serv) fillBoard(board *[rows][cols]int, freeSpin bool) {
 	for r := 0; r < rows; r++ {
 		for c := 0; c < cols; c++ {
-			if rand.Float64() < s.cfg.BonusProbPerColumn() {
-				board[r][c] = symbolBonus
-			} else {
-				board[r][c] = s.randomRegularSymbol()
-			}
+			board[r][c] = emptyCell
 		}
 	}
+
+	placer := s.newScatterPlacer(*board, freeSpin)
+	for c := 0; c < cols; c++ {
+		placer.place(board, c)
+		s.fillRegular(board, c)
+	}
 }
 
 // collapseAndRefill сдвигает символы вниз и заполняет пустоты новыми символами
-func (s *serv) collapseAndRefill(board *[rows][cols]int) {
+func (s *serv) collapseAndRefill(board *[rows][cols]int, freeSpin bool) {
+	// Бонусы при каскаде не удаляются — лимиты считаем с учётом уже лежащих на поле
+	placer := s.newScatterPlacer(*board, freeSpin)
+
 	for c := 0; c < cols; c++ {
 		stack := make([]int, 0, rows)
 		for r := 0; r < rows; r++ {
@@ -265,14 +334,16 @@ func (s *serv) collapseAndRefill(board *[rows][cols]int) {
 			board[rows-len(stack)+i][c] = sym
 		}
 
-		for r := 0; r < rows; r++ {
-			if board[r][c] == emptyCell {
-				if rand.Float64() < s.cfg.BonusProbPerColumn() {
-					board[r][c] = symbolBonus
-				} else {
-					board[r][c] = s.randomRegularSymbol()
-				}
-			}
+		placer.place(board, c)
+		s.fillRegular(board, c)
+	}
+}
+
+// fillRegular заполняет оставшиеся пустые ячейки колонки обычными символами
+func (s *serv) fillRegular(board *[rows][cols]int, c int) {
+	for r := 0; r < rows; r++ {
+		if board[r][c] == emptyCell {
+			board[r][c] = s.randomRegularSymbol()
 		}
 	}
 }
@@ -281,22 +352,42 @@ func (s *serv) collapseAndRefill(board *[rows][cols]int) {
 func (s *serv) randomRegularSymbol() int {
 	weights := s.cfg.SymbolWeights()
 	total := 0
-	for _, w := range weights {
-		total += w
+	for sym, w := range weights {
+		// Бонус выставляется только через scatterPlacer, чтобы соблюдались лимиты
+		if sym != symbolBonus {
+			total += w
+		}
 	}
 	if total == 0 {
 		return 0
 	}
-	n := rand.Intn(total)
-	for sym, w := range weights {
-		if n < w {
+	// Обходим символы по возрастанию, чтобы выбор не зависел от порядка обхода map
+	symbols := make([]int, 0, len(weights))
+	for sym := range weights {
+		if sym != symbolBonus {
+			symbols = append(symbols, sym)
+		}
+	}
+	sort.Ints(symbols)
+
+	n := s.rng.Intn(total)
+	for _, sym := range symbols {
+		if n < weights[sym] {
 			return sym
 		}
-		n -= w
+		n -= weights[sym]
 	}
 	return 0
 }
 
+// findWins ищет выигрышные группы символов способом, выбранным в конфиге
+func (s *serv) findWins(board [rows][cols]int) []cluster {
+	if s.cfg.Evaluator() == config.CascadeEvaluatorPayAnywhere {
+		return s.findPayAnywhere(board)
+	}
+	return s.findClusters(board)
+}
+
 // findClusters ищет кластеры на доске
 // Использует BFS для поиска всех связанных ячеек одного символа
 func (s *serv) findClusters(board [rows][cols]int) []cluster {
@@ -391,38 +482,7 @@ func (s *serv) calculateWin(cl cluster, mult [rows][cols]int, bet int) int {
 	// Базовая выплата: base × количество символов
 	baseWin := base * length
 
-	// Суммируем множители по всем ячейкам кластера
-	var sumMult int
-	for _, cell := range cl.cells {
-		sumMult += mult[cell[0]][cell[1]]
-	}
-
-	// Средний множитель (округление вниз — как в оригинале)
-	avgMult := sumMult / length
-	if avgMult < 1 {
-		avgMult = 1
-	}
-
-	return baseWin * avgMult * bet
-}
-
-// averageMultiplier возвращает средний множитель кластера (для отображения клиенту)
-func (s *serv) averageMultiplier(cl cluster, mult [rows][cols]int) int {
-	length := len(cl.cells)
-	if length == 0 {
-		return 1
-	}
-
-	var sum int
-	for _, cell := range cl.cells {
-		sum += mult[cell[0]][cell[1]]
-	}
-
-	avg := sum / length
-	if avg < 1 {
-		avg = 1
-	}
-	return avg
+	return baseWin * s.clusterMultiplier(cl, mult) * bet
 }
 
 // removeCluster удаляет кластер с доски и обновляет счётчики попаданий и множители
@@ -430,14 +490,7 @@ func (s *serv) removeCluster(cl cluster, board *[rows][cols]int, hits *[rows][co
 	for _, cell := range cl.cells {
 		r, c := cell[0], cell[1]
 		hits[r][c]++
-		if hits[r][c] >= 2 {
-			shift := uint(hits[r][c] - 2)
-			newMult := multiplierStart << shift
-			if newMult > multiplierMax {
-				newMult = multiplierMax
-			}
-			mult[r][c] = newMult
-		}
+		mult[r][c] = s.nextMultiplier(hits[r][c], mult[r][c])
 		board[r][c] = emptyCell
 	}
 }
@@ -455,11 +508,11 @@ func (s *serv) countScatters(board [rows][cols]int) int {
 	return cnt
 }
 
-// applyMaxPayout ограничивает максимальный выигрыш
-func (s *serv) applyMaxPayout(amount, bet int) int {
-	maxAllowed := maxWinXBet * bet
-	if amount > maxAllowed {
-		return maxAllowed
+// remainingWinCap сколько ещё можно выиграть до лимита, действующего на весь бонус целиком
+func (s *serv) remainingWinCap(bet, featureWin int) int {
+	winCap := s.cfg.MaxWinXBet()*bet - featureWin
+	if winCap < 0 {
+		return 0
 	}
-	return amount
+	return winCap
 }
@@ -0,0 +1,88 @@
+package cascade
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/service/wheel"
+	"context"
+	"errors"
+)
+
+// startWheel запускает колесо фортуны вместо фриспинов, если так настроены бонусные символы
+func (s *serv) startWheel(res *model.CascadeSpinResult, bet int) (*model.WheelView, error) {
+	if s.cfg.ScatterFeature() != config.ScatterFeatureWheel || res.AwardedFreeSpins == 0 || res.MaxWinReached {
+		return nil, nil
+	}
+	res.AwardedFreeSpins = 0
+
+	state := wheel.Start(s.wheelCfg, bet)
+	if err := s.repo.UpdateWheelState(state); err != nil {
+		return nil, errors.New("failed to save wheel state")
+	}
+	view, err := wheel.View(s.wheelCfg, state)
+	if err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+// checkNoActiveWheel запрещает спины и покупку бонуса, пока не докручено колесо
+func (s *serv) checkNoActiveWheel() error {
+	state, err := s.repo.GetWheelState()
+	if err != nil {
+		return errors.New("failed to get wheel state")
+	}
+	if state.Active {
+		return errors.New("wheel bonus is in progress")
+	}
+	return nil
+}
+
+// WheelSpin крутит колесо фортуны; выигрыш идёт на баланс, фриспины — на счёт фриспинов
+func (s *serv) WheelSpin(ctx context.Context) (*model.WheelResult, error) {
+	state, err := s.repo.GetWheelState()
+	if err != nil {
+		return nil, errors.New("failed to get wheel state")
+	}
+
+	spin, err := wheel.Spin(s.wheelCfg, s.rng, &state)
+	if err != nil {
+		return nil, err
+	}
+	// Колесо — часть того же бонуса: лимит учитывает выигрыш запустившего спина,
+	// а фриспины с колеса продолжают считать выигрыш от общей суммы
+	featureWin, err := s.repo.GetFeatureWin()
+	if err != nil {
+		return nil, errors.New("failed to get feature win")
+	}
+	spin.Payout = min(spin.Payout, s.remainingWinCap(state.Bet, featureWin))
+	if err := s.repo.UpdateFeatureWin(featureWin + spin.Payout); err != nil {
+		return nil, errors.New("failed to update feature win")
+	}
+
+	balance, err := s.wallet.Credit(spin.Payout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	freeSpins, err := s.repo.GetFreeSpinCount()
+	if err != nil {
+		return nil, errors.New("failed to get count free spins")
+	}
+	if spin.FreeSpins > 0 {
+		freeSpins += spin.FreeSpins
+		if err := s.repo.UpdateFreeSpinCount(freeSpins); err != nil {
+			return nil, errors.New("failed to update count free spins")
+		}
+	}
+
+	if err := s.repo.UpdateWheelState(state); err != nil {
+		return nil, errors.New("failed to save wheel state")
+	}
+
+	return &model.WheelResult{
+		Spin:          spin,
+		Balance:       balance,
+		FreeSpinCount: freeSpins,
+	}, nil
+}
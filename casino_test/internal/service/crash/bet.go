@@ -0,0 +1,116 @@
+package crash
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// Минимальный автокэшаут — ниже него ставка не имела бы смысла
+const minAutoCashout = 1.01
+
+// PlaceBet принимает ставку игрока на ближайший раунд, пока идёт приём ставок
+func (s *serv) PlaceBet(ctx context.Context, bet model.CrashBet) (*model.CrashBet, error) {
+	if bet.PlayerID == "" {
+		return nil, errors.New("player id is required")
+	}
+	if bet.Amount < s.cfg.MinBet() || bet.Amount > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+	if bet.AutoCashout != 0 && bet.AutoCashout < minAutoCashout {
+		return nil, fmt.Errorf("auto cashout must be at least %.2f", minAutoCashout)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.repo.GetRound()
+	if err != nil {
+		return nil, errors.New("failed to get crash round")
+	}
+	if round.Phase != model.CrashPhaseBetting {
+		return nil, errors.New("bets are closed: wait for the next round")
+	}
+	bets, err := s.repo.GetBets()
+	if err != nil {
+		return nil, errors.New("failed to get crash bets")
+	}
+	for _, b := range bets {
+		if b.PlayerID == bet.PlayerID {
+			return nil, errors.New("bet is already placed in this round")
+		}
+	}
+
+	token, err := newBetToken()
+	if err != nil {
+		return nil, errors.New("failed to create bet token")
+	}
+	if _, err := s.wallet.Debit(bet.Amount); err != nil {
+		return nil, err
+	}
+	placed := model.CrashBet{PlayerID: bet.PlayerID, Token: token, Amount: bet.Amount, AutoCashout: bet.AutoCashout}
+	if err := s.repo.UpdateBet(placed); err != nil {
+		return nil, errors.New("failed to save crash bet")
+	}
+
+	s.publish(model.CrashEvent{Type: model.CrashEventBet, Round: round, Bet: &placed})
+	return &placed, nil
+}
+
+// Cashout забирает выигрыш по текущему множителю, если раунд ещё не крашнулся.
+// Ставка ищется по секрету, выданному в PlaceBet, — имя игрока для этого не годится, его видят все
+func (s *serv) Cashout(ctx context.Context, token string) (*model.CrashBet, error) {
+	if token == "" {
+		return nil, errors.New("bet token is required")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.repo.GetRound()
+	if err != nil {
+		return nil, errors.New("failed to get crash round")
+	}
+	if round.Phase != model.CrashPhaseRunning {
+		return nil, errors.New("round is not running")
+	}
+	// Множитель считаем на момент запроса, а не последнего тика; точка краша уже проигрывает
+	mult := s.currentMultiplier(round)
+	if mult >= s.point {
+		return nil, errors.New("round has already crashed")
+	}
+
+	bets, err := s.repo.GetBets()
+	if err != nil {
+		return nil, errors.New("failed to get crash bets")
+	}
+	for _, bet := range bets {
+		if subtle.ConstantTimeCompare([]byte(bet.Token), []byte(token)) != 1 {
+			continue
+		}
+		if bet.CashedOut {
+			return nil, errors.New("bet is already cashed out")
+		}
+		settled, err := s.settle(bet, mult)
+		if err != nil {
+			return nil, errors.New("failed to pay crash cashout")
+		}
+		round.Multiplier = mult
+		s.publish(model.CrashEvent{Type: model.CrashEventCashout, Round: round, Bet: settled})
+		return settled, nil
+	}
+	return nil, errors.New("no bet in this round")
+}
+
+// newBetToken секрет ставки, по которому её владелец забирает выигрыш
+func newBetToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,45 @@
+package crash
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strconv"
+)
+
+// newSeed случайный seed раунда
+func newSeed() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// seedHash хэш seed, который публикуется до начала раунда
+func seedHash(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// crashPoint выводит точку краша из seed и номера раунда, так что её может проверить любой игрок.
+// HMAC-SHA256(seed, номер) даёт r из [0, 1), точка = (1 - edge) / (1 - r) с округлением вниз до сотых.
+// Вероятность дожить до множителя x равна (1 - edge) / x.
+func crashPoint(seed string, roundID int, edge, maxMult float64) float64 {
+	mac := hmac.New(sha256.New, []byte(seed))
+	mac.Write([]byte(strconv.Itoa(roundID)))
+	sum := mac.Sum(nil)
+
+	// Старшие 52 бита — ровно столько помещается в мантиссу float64
+	r := float64(binary.BigEndian.Uint64(sum[:8])>>12) / float64(uint64(1)<<52)
+	point := math.Floor((1-edge)/(1-r)*100) / 100
+	return math.Min(math.Max(point, 1), maxMult)
+}
+
+// multiplierAt множитель через elapsed секунд после старта раунда
+func multiplierAt(seconds, rate float64) float64 {
+	return math.Floor(math.Exp(rate*seconds)*100) / 100
+}
@@ -0,0 +1,82 @@
+package crash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestCrashPointIsReproducible(t *testing.T) {
+	for round := 1; round <= 100; round++ {
+		a := crashPoint("seed", round, 0.01, 1000)
+		if b := crashPoint("seed", round, 0.01, 1000); a != b {
+			t.Fatalf("round %d: crash point %v then %v for the same seed", round, a, b)
+		}
+	}
+}
+
+func TestCrashPointBounds(t *testing.T) {
+	tests := []struct {
+		name    string
+		edge    float64
+		maxMult float64
+	}{
+		{"no edge", 0, 1000},
+		{"house edge", 0.03, 1000},
+		{"low cap", 0.01, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for round := 1; round <= 1000; round++ {
+				p := crashPoint("seed", round, tt.edge, tt.maxMult)
+				if p < 1 || p > tt.maxMult {
+					t.Fatalf("round %d: crash point %v is outside [1, %v]", round, p, tt.maxMult)
+				}
+			}
+		})
+	}
+}
+
+// Доля раундов, переживших x, должна быть около (1 - edge) / x
+func TestCrashPointDistribution(t *testing.T) {
+	const rounds = 20000
+	const edge = 0.01
+	for _, x := range []float64{1.5, 2, 10} {
+		survived := 0
+		for round := 1; round <= rounds; round++ {
+			if crashPoint("distribution", round, edge, 1e6) >= x {
+				survived++
+			}
+		}
+		got := float64(survived) / rounds
+		want := (1 - edge) / x
+		if got < want*0.9 || got > want*1.1 {
+			t.Errorf("P(crash >= %v) = %.4f, want about %.4f", x, got, want)
+		}
+	}
+}
+
+func TestSeedHash(t *testing.T) {
+	sum := sha256.Sum256([]byte("seed"))
+	if got, want := seedHash("seed"), hex.EncodeToString(sum[:]); got != want {
+		t.Errorf("seedHash = %s, want %s", got, want)
+	}
+}
+
+func TestMultiplierAt(t *testing.T) {
+	tests := []struct {
+		seconds float64
+		rate    float64
+		want    float64
+	}{
+		{0, 0.06, 1},
+		{1, 0.06, 1.06},
+		{10, 0.06, 1.82},
+		{10, 0, 1},
+	}
+	for _, tt := range tests {
+		if got := multiplierAt(tt.seconds, tt.rate); got != tt.want {
+			t.Errorf("multiplierAt(%v, %v) = %v, want %v", tt.seconds, tt.rate, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package crash
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/money"
+	"context"
+	"log"
+	"math"
+	"time"
+)
+
+// Run крутит раунды один за другим: приём ставок → рост множителя → краш → пауза
+func (s *serv) Run(ctx context.Context) {
+	for {
+		if err := s.playRound(ctx); err != nil {
+			log.Printf("crash round failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(s.cfg.PauseSeconds()) * time.Second):
+		}
+	}
+}
+
+// playRound проводит один раунд
+func (s *serv) playRound(ctx context.Context) error {
+	round, err := s.openRound()
+	if err != nil {
+		return err
+	}
+	s.publish(model.CrashEvent{Type: model.CrashEventRound, Round: round})
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(time.Until(round.BetsUntil)):
+	}
+
+	round, err = s.startRound()
+	if err != nil {
+		return err
+	}
+	s.publish(model.CrashEvent{Type: model.CrashEventRound, Round: round})
+
+	ticker := time.NewTicker(time.Duration(s.cfg.TickMillis()) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		round, crashed, err := s.tick()
+		if err != nil {
+			return err
+		}
+		if crashed {
+			s.publish(model.CrashEvent{Type: model.CrashEventCrash, Round: round})
+			return nil
+		}
+		s.publish(model.CrashEvent{Type: model.CrashEventTick, Round: round})
+	}
+}
+
+// openRound начинает приём ставок: seed уже выбран, игрокам виден только его хэш
+func (s *serv) openRound() (model.CrashRound, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	prev, err := s.repo.GetRound()
+	if err != nil {
+		return model.CrashRound{}, err
+	}
+	seed, err := newSeed()
+	if err != nil {
+		return model.CrashRound{}, err
+	}
+
+	round := model.CrashRound{
+		ID:         prev.ID + 1,
+		Phase:      model.CrashPhaseBetting,
+		Hash:       seedHash(seed),
+		Multiplier: 1,
+		BetsUntil:  time.Now().Add(time.Duration(s.cfg.BettingSeconds()) * time.Second),
+	}
+	s.seed = seed
+	s.point = crashPoint(seed, round.ID, s.cfg.HouseEdge(), s.cfg.MaxMultiplier())
+
+	if err := s.repo.ClearBets(); err != nil {
+		return model.CrashRound{}, err
+	}
+	if err := s.repo.UpdateRound(round); err != nil {
+		return model.CrashRound{}, err
+	}
+	return round, nil
+}
+
+// startRound закрывает приём ставок и запускает множитель
+func (s *serv) startRound() (model.CrashRound, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.repo.GetRound()
+	if err != nil {
+		return model.CrashRound{}, err
+	}
+	round.Phase = model.CrashPhaseRunning
+	round.StartedAt = time.Now()
+	if err := s.repo.UpdateRound(round); err != nil {
+		return model.CrashRound{}, err
+	}
+	return round, nil
+}
+
+// tick обновляет множитель, выплачивает автокэшауты и проверяет краш
+func (s *serv) tick() (model.CrashRound, bool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.repo.GetRound()
+	if err != nil {
+		return model.CrashRound{}, false, err
+	}
+	mult := s.currentMultiplier(round)
+	crashed := mult >= s.point
+	round.Multiplier = math.Min(mult, s.point)
+
+	// Автокэшаут срабатывает на своём множителе, если раунд до него дожил.
+	// Как и при ручном кэшауте, сама точка краша проигрывает
+	bets, err := s.repo.GetBets()
+	if err != nil {
+		return model.CrashRound{}, false, err
+	}
+	for _, bet := range bets {
+		if bet.CashedOut || bet.AutoCashout == 0 || bet.AutoCashout > round.Multiplier || bet.AutoCashout >= s.point {
+			continue
+		}
+		settled, err := s.settle(bet, bet.AutoCashout)
+		if err != nil {
+			return model.CrashRound{}, false, err
+		}
+		s.publish(model.CrashEvent{Type: model.CrashEventCashout, Round: round, Bet: settled})
+	}
+
+	if crashed {
+		// Раунд окончен — раскрываем seed, чтобы точку краша можно было проверить
+		round.Phase = model.CrashPhaseCrashed
+		round.Seed = s.seed
+		round.CrashPoint = s.point
+		if err := s.repo.AddHistory(round); err != nil {
+			return model.CrashRound{}, false, err
+		}
+	}
+	if err := s.repo.UpdateRound(round); err != nil {
+		return model.CrashRound{}, false, err
+	}
+	return round, crashed, nil
+}
+
+// currentMultiplier множитель запущенного раунда на текущий момент
+func (s *serv) currentMultiplier(round model.CrashRound) float64 {
+	return multiplierAt(time.Since(round.StartedAt).Seconds(), s.cfg.GrowthRate())
+}
+
+// settle выплачивает ставку по множителю; вызывается под s.mtx
+func (s *serv) settle(bet model.CrashBet, mult float64) (*model.CrashBet, error) {
+	bet.CashedOut = true
+	bet.CashoutAt = mult
+	bet.Payout = money.Payout(bet.Amount, mult)
+
+	if _, err := s.wallet.Credit(bet.Payout); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateBet(bet); err != nil {
+		return nil, err
+	}
+	return &bet, nil
+}
@@ -0,0 +1,69 @@
+package crash
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"sync"
+)
+
+// Сколько событий может ждать медленный подписчик, прежде чем они начнут теряться
+const subscriberBuffer = 64
+
+type serv struct {
+	cfg    config.CrashConfig
+	repo   repository.CrashRepository
+	wallet repository.WalletRepository
+
+	// Раунд, ставки и выплаты меняются под одним замком — тики и запросы игроков не пересекаются
+	mtx sync.Mutex
+	// Seed и точка краша текущего раунда до краша известны только серверу
+	seed  string
+	point float64
+
+	subMtx sync.Mutex
+	subs   map[chan model.CrashEvent]struct{}
+}
+
+// NewCrashService Создать краш-игру с общими для всех игроков раундами
+func NewCrashService(cfg config.CrashConfig, repo repository.CrashRepository, wallet repository.WalletRepository) service.CrashService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		subs:   map[chan model.CrashEvent]struct{}{},
+	}
+}
+
+// Subscribe подписывает на ленту событий
+func (s *serv) Subscribe() (<-chan model.CrashEvent, func()) {
+	ch := make(chan model.CrashEvent, subscriberBuffer)
+
+	s.subMtx.Lock()
+	s.subs[ch] = struct{}{}
+	s.subMtx.Unlock()
+
+	unsubscribe := func() {
+		s.subMtx.Lock()
+		defer s.subMtx.Unlock()
+		if _, ok := s.subs[ch]; ok {
+			delete(s.subs, ch)
+			close(ch)
+		}
+	}
+	return ch, unsubscribe
+}
+
+// publish рассылает событие всем подписчикам; тем, кто не успевает читать, событие не достаётся
+func (s *serv) publish(event model.CrashEvent) {
+	s.subMtx.Lock()
+	defer s.subMtx.Unlock()
+
+	for ch := range s.subs {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
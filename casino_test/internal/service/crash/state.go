@@ -0,0 +1,29 @@
+package crash
+
+import (
+	"casino_test/internal/model"
+	"context"
+)
+
+// State текущий раунд, его ставки и последние раунды
+func (s *serv) State(ctx context.Context) (*model.CrashState, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.repo.GetRound()
+	if err != nil {
+		return nil, err
+	}
+	if round.Phase == model.CrashPhaseRunning {
+		round.Multiplier = min(s.currentMultiplier(round), s.point)
+	}
+	bets, err := s.repo.GetBets()
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.repo.GetHistory()
+	if err != nil {
+		return nil, err
+	}
+	return &model.CrashState{Round: round, Bets: bets, History: history}, nil
+}
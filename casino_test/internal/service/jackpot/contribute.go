@@ -0,0 +1,83 @@
+package jackpot
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Contribute отчисляет долю ставки во все пулы и проверяет их выпадение.
+// Выпавший пул выплачивается в кошелёк и сбрасывается к стартовому значению.
+// gameID и scatters — в какой игре и сколько скаттеров выпало в спине (для джекпотов с символьным триггером).
+func (s *serv) Contribute(ctx context.Context, gameID string, bet, scatters int) ([]model.JackpotWin, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	pools, err := s.pools()
+	if err != nil {
+		return nil, err
+	}
+
+	wins := []model.JackpotWin{}
+	for i, tier := range s.cfg.Tiers() {
+		pool := &pools[i]
+		pool.Value += float64(bet) * tier.Contribution
+
+		var hit bool
+		switch tier.Trigger {
+		case config.JackpotTriggerMustDrop:
+			hit = pool.Value >= pool.DropAt
+		case config.JackpotTriggerSymbol:
+			count, ok := tier.SymbolCounts[gameID]
+			hit = ok && scatters >= count
+		}
+		if !hit {
+			continue
+		}
+
+		win := model.JackpotWin{ID: tier.ID, Amount: int(pool.Value)}
+		if _, err := s.wallet.Credit(win.Amount); err != nil {
+			return nil, errors.New("failed to pay jackpot")
+		}
+		wins = append(wins, win)
+		*pool = s.newPool(tier)
+	}
+
+	if err := s.repo.UpdatePools(pools); err != nil {
+		return nil, errors.New("failed to save jackpot pools")
+	}
+	return wins, nil
+}
+
+// pools возвращает пулы в порядке уровней из конфига, создавая недостающие со стартовым значением
+func (s *serv) pools() ([]model.JackpotPool, error) {
+	stored, err := s.repo.GetPools()
+	if err != nil {
+		return nil, errors.New("failed to get jackpot pools")
+	}
+	byID := make(map[string]model.JackpotPool, len(stored))
+	for _, pool := range stored {
+		byID[pool.ID] = pool
+	}
+
+	tiers := s.cfg.Tiers()
+	pools := make([]model.JackpotPool, len(tiers))
+	for i, tier := range tiers {
+		pool, ok := byID[tier.ID]
+		if !ok {
+			pool = s.newPool(tier)
+		}
+		pools[i] = pool
+	}
+	return pools, nil
+}
+
+// newPool пул со стартовым значением; для must-drop заранее выбирается точка выпадения
+func (s *serv) newPool(tier config.JackpotTier) model.JackpotPool {
+	pool := model.JackpotPool{ID: tier.ID, Value: float64(tier.Seed)}
+	if tier.Trigger == config.JackpotTriggerMustDrop {
+		pool.DropAt = float64(tier.Seed) + s.rng.Float64()*float64(tier.MustDropBy-tier.Seed)
+	}
+	return pool
+}
@@ -0,0 +1,14 @@
+package jackpot
+
+import (
+	"casino_test/internal/model"
+	"context"
+)
+
+// Pools текущие значения пулов для бегущей строки на фронте
+func (s *serv) Pools(ctx context.Context) ([]model.JackpotPool, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.pools()
+}
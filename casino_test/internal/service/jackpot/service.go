@@ -0,0 +1,28 @@
+package jackpot
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"sync"
+)
+
+type serv struct {
+	cfg    config.JackpotConfig
+	repo   repository.JackpotRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+	// Отчисление и выпадение — чтение и запись пулов одной операцией
+	mtx sync.Mutex
+}
+
+// NewJackpotService Создать сервис прогрессивных джекпотов
+func NewJackpotService(cfg config.JackpotConfig, repo repository.JackpotRepository, wallet repository.WalletRepository, r rng.RNG) service.JackpotService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
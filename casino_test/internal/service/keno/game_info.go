@@ -0,0 +1,20 @@
+package keno
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+)
+
+// GameInfo возвращает правила кено и таблицу выплат
+func (s *serv) GameInfo() (*model.KenoGameInfo, error) {
+	return &model.KenoGameInfo{
+		Numbers:  config.KenoNumbers,
+		Drawn:    config.KenoDrawn,
+		MinPicks: config.KenoMinPicks,
+		MaxPicks: config.KenoMaxPicks,
+		MaxDraws: s.cfg.MaxDraws(),
+		MinBet:   s.cfg.MinBet(),
+		MaxBet:   s.cfg.MaxBet(),
+		PayTable: s.cfg.PayTable(),
+	}, nil
+}
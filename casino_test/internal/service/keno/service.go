@@ -0,0 +1,29 @@
+package keno
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"sync"
+)
+
+type serv struct {
+	cfg    config.KenoConfig
+	repo   repository.KenoRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+
+	// Номера билетов и тиражей идут подряд — покупки выполняются по одной
+	mtx sync.Mutex
+}
+
+// NewKenoService Создать кено 20 из 80
+func NewKenoService(cfg config.KenoConfig, repo repository.KenoRepository, wallet repository.WalletRepository, r rng.RNG) service.KenoService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
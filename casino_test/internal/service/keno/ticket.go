@@ -0,0 +1,111 @@
+package keno
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/service/money"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// BuyTicket списывает ставку сразу за все тиражи билета и разыгрывает их подряд.
+// Каждый тираж получает свой сквозной номер; результаты хранятся вместе с билетом.
+func (s *serv) BuyTicket(ctx context.Context, purchase model.KenoPurchase) (*model.KenoTicketResult, error) {
+	picks, err := validatePicks(purchase.Picks)
+	if err != nil {
+		return nil, err
+	}
+	if purchase.Bet < s.cfg.MinBet() || purchase.Bet > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+	if purchase.Draws == 0 {
+		purchase.Draws = 1
+	}
+	if purchase.Draws < 1 || purchase.Draws > s.cfg.MaxDraws() {
+		return nil, fmt.Errorf("draws must be between 1 and %d", s.cfg.MaxDraws())
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	tickets, err := s.repo.GetTickets()
+	if err != nil {
+		return nil, errors.New("failed to get keno tickets")
+	}
+	ticketID, drawID := 1, 1
+	if len(tickets) > 0 {
+		last := tickets[0]
+		ticketID = last.ID + 1
+		drawID = last.Results[len(last.Results)-1].DrawID + 1
+	}
+
+	totalBet := purchase.Bet * purchase.Draws
+	if _, err := s.wallet.Debit(totalBet); err != nil {
+		return nil, err
+	}
+
+	ticket := model.KenoTicket{
+		ID:       ticketID,
+		Picks:    picks,
+		Bet:      purchase.Bet,
+		Draws:    purchase.Draws,
+		TotalBet: totalBet,
+		Results:  make([]model.KenoDraw, purchase.Draws),
+	}
+	pays := s.cfg.PayTable()[len(picks)]
+	for i := range ticket.Results {
+		draw := model.KenoDraw{DrawID: drawID + i, Numbers: s.drawNumbers()}
+		for _, n := range picks {
+			if _, found := slices.BinarySearch(draw.Numbers, n); found {
+				draw.Hits = append(draw.Hits, n)
+			}
+		}
+		draw.Multiplier = pays[len(draw.Hits)]
+		draw.Payout = money.Payout(purchase.Bet, draw.Multiplier)
+		ticket.TotalPayout += draw.Payout
+		ticket.Results[i] = draw
+	}
+
+	balance, err := s.wallet.Credit(ticket.TotalPayout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+	if err := s.repo.AddTicket(ticket); err != nil {
+		return nil, errors.New("failed to save keno ticket")
+	}
+
+	return &model.KenoTicketResult{Ticket: ticket, Balance: balance}, nil
+}
+
+// validatePicks проверяет отметки (1..10 разных чисел из 1..80) и возвращает их по возрастанию
+func validatePicks(picks []int) ([]int, error) {
+	if len(picks) < config.KenoMinPicks || len(picks) > config.KenoMaxPicks {
+		return nil, fmt.Errorf("pick between %d and %d numbers", config.KenoMinPicks, config.KenoMaxPicks)
+	}
+	sorted := slices.Sorted(slices.Values(picks))
+	for i, n := range sorted {
+		if n < 1 || n > config.KenoNumbers {
+			return nil, fmt.Errorf("numbers must be between 1 and %d", config.KenoNumbers)
+		}
+		if i > 0 && n == sorted[i-1] {
+			return nil, errors.New("numbers must not repeat")
+		}
+	}
+	return sorted, nil
+}
+
+// drawNumbers вытягивает 20 разных чисел из 80 и возвращает их по возрастанию
+func (s *serv) drawNumbers() []int {
+	balls := make([]int, config.KenoNumbers)
+	for i := range balls {
+		balls[i] = i + 1
+	}
+	s.rng.Shuffle(len(balls), func(i, j int) {
+		balls[i], balls[j] = balls[j], balls[i]
+	})
+	drawn := balls[:config.KenoDrawn]
+	slices.Sort(drawn)
+	return drawn
+}
@@ -0,0 +1,28 @@
+package keno
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Tickets возвращает последние билеты с результатами всех тиражей (новые в начале)
+func (s *serv) Tickets(ctx context.Context) ([]model.KenoTicket, error) {
+	tickets, err := s.repo.GetTickets()
+	if err != nil {
+		return nil, errors.New("failed to get keno tickets")
+	}
+	return tickets, nil
+}
+
+// Ticket возвращает билет по номеру
+func (s *serv) Ticket(ctx context.Context, id int) (*model.KenoTicket, error) {
+	ticket, ok, err := s.repo.GetTicket(id)
+	if err != nil {
+		return nil, errors.New("failed to get keno ticket")
+	}
+	if !ok {
+		return nil, errors.New("ticket not found")
+	}
+	return &ticket, nil
+}
@@ -2,20 +2,35 @@ package line
 
 import (
 	"errors"
+	"fmt"
 )
 
 // Купить бонуску
 func (s *serv) BuyBonus(amount int) error {
 	cost := amount
 
-	balance, err := s.repo.GetBalance()
-	if err != nil {
-		return errors.New("failed to get user balance")
+	// Цена бонуса — buyBonusMultiplier ставок; ставка должна быть на лестнице ставок, как в спине
+	if cost <= 0 || cost%buyBonusMultiplier != 0 {
+		return fmt.Errorf("bonus buy amount must be a positive multiple of %d", buyBonusMultiplier)
 	}
-	if balance < cost {
-		return errors.New("not enough balance for bonus buy")
+	if !s.betCfg.IsAllowedBet(cost / buyBonusMultiplier) {
+		return errors.New("bet is not on the bet ladder")
+	}
+
+	if err := s.checkNoActiveFeature(); err != nil {
+		return err
+	}
+
+	if _, err := s.wallet.Debit(cost); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateFreeSpinCount(10); err != nil {
+		return errors.New("failed to update free spin count after bonus buy")
+	}
+	// Купленный бонус играет на всех линиях от ставки, за которую куплен, без липких вайлдов и с множителем x1
+	bet := cost / buyBonusMultiplier
+	if err := s.repo.UpdateFreeSpinState(newFreeSpinState(bet, len(s.cfg.Paylines()), 0)); err != nil {
+		return errors.New("failed to reset free spin state after bonus buy")
 	}
-	err = s.repo.UpdateBalance(balance - cost)
-	err = s.repo.UpdateFreeSpinCount(10)
 	return nil
 }
@@ -1,9 +1,12 @@
 package line
 
-import "casino_test/internal/model"
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/wheel"
+)
 
 func (s *serv) CheckData() (*model.Data, error) {
-	balance, err := s.repo.GetBalance()
+	balance, err := s.wallet.GetBalance()
 	if err != nil {
 		return nil, err
 	}
@@ -11,8 +14,45 @@ func (s *serv) CheckData() (*model.Data, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &model.Data{
+	data := &model.Data{
 		Balance:       balance, // Используем экспортированные имена
 		FreeSpinCount: freeSpins,
-	}, nil
+	}
+
+	holdAndWin, err := s.repo.GetHoldAndWinState()
+	if err != nil {
+		return nil, err
+	}
+	if holdAndWin.Active {
+		data.HoldAndWin = &holdAndWin
+	}
+
+	pickEm, err := s.repo.GetPickEmState()
+	if err != nil {
+		return nil, err
+	}
+	if pickEm.Active {
+		data.PickEm = &pickEm
+	}
+
+	wheelState, err := s.repo.GetWheelState()
+	if err != nil {
+		return nil, err
+	}
+	if wheelState.Active {
+		view, err := wheel.View(s.wheelCfg, wheelState)
+		if err != nil {
+			return nil, err
+		}
+		data.Wheel = &view
+	}
+
+	gamble, err := s.repo.GetGambleState()
+	if err != nil {
+		return nil, err
+	}
+	if gamble.Active {
+		data.Gamble = &gamble
+	}
+	return data, nil
 }
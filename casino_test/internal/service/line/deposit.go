@@ -1,10 +0,0 @@
-package line
-
-// Пополнить баланс
-func (s *serv) Deposit(amount int) error {
-	err := s.repo.UpdateBalance(amount)
-	if err != nil {
-		return err
-	}
-	return nil
-}
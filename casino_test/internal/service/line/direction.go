@@ -0,0 +1,33 @@
+package line
+
+import "casino_test/internal/config"
+
+// payDirections возвращает направления, в которых проверяются выигрыши
+func (s *serv) payDirections() []string {
+	switch s.cfg.PayDirection() {
+	case config.PayDirectionRightToLeft:
+		return []string{config.PayDirectionRightToLeft}
+	case config.PayDirectionBoth:
+		return []string{config.PayDirectionLeftToRight, config.PayDirectionRightToLeft}
+	default:
+		return []string{config.PayDirectionLeftToRight}
+	}
+}
+
+// skipFullLineRightToLeft — полная комбинация уже оплачена слева направо и второй раз не платится
+func (s *serv) skipFullLineRightToLeft() bool {
+	return s.cfg.PayDirection() == config.PayDirectionBoth && s.cfg.BothWaysFullLine() == config.FullLinePayOnce
+}
+
+// reelOrder возвращает порядок барабанов для чтения комбинации в заданном направлении
+func reelOrder(dir string) []int {
+	order := make([]int, reels)
+	for i := range order {
+		if dir == config.PayDirectionRightToLeft {
+			order[i] = reels - 1 - i
+		} else {
+			order[i] = i
+		}
+	}
+	return order
+}
@@ -0,0 +1,203 @@
+package line
+
+import (
+	"casino_test/internal/config/env"
+	"casino_test/internal/model"
+	"casino_test/pkg/rng"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// testConfig небольшой слот: три линии, вайлды платят и сами по себе
+const testConfig = `
+line_symbol_weights: {S1: 10, S2: 10, S3: 10, S8: 5, B: 2, W: 1}
+line_wild_chance_on_reel_2_3_4: 0.1
+line_free_spins_by_scatter: {3: 10}
+line_payout_table:
+  S1: {3: 20, 4: 100, 5: 500}
+  S2: {3: 30, 4: 150, 5: 600}
+  S8: {2: 10, 3: 50, 4: 200, 5: 1000}
+  B: {3: 100}
+line_wild_payout_table: {3: 200, 4: 300, 5: 2000}
+line_paylines:
+  - [1, 1, 1, 1, 1]
+  - [0, 0, 0, 0, 0]
+  - [2, 2, 2, 2, 2]
+`
+
+// newTestServ слот на testConfig; extra дописывается к конфигу (ключи не должны повторяться)
+func newTestServ(t *testing.T, extra string) *serv {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(testConfig+extra), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := env.NewLineConfigFromYAML(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &serv{cfg: cfg, rng: rng.NewSeeded(1)}
+}
+
+func TestMatchLine(t *testing.T) {
+	s := newTestServ(t, "")
+	tests := []struct {
+		name      string
+		symbols   []string
+		wantSym   string
+		wantCount int
+		wantVal   int
+	}{
+		{"three of a kind", []string{"S1", "S1", "S1", "S2", "S2"}, "S1", 3, 20},
+		{"wilds substitute", []string{"W", "W", "S1", "S1", "S2"}, "S1", 4, 100},
+		{"wilds in the middle", []string{"S2", "W", "S2", "W", "S2"}, "S2", 5, 600},
+		{"two of a kind pays for S8", []string{"S8", "S8", "S1", "S1", "S1"}, "S8", 2, 10},
+		{"symbol beats shorter wild line", []string{"W", "W", "W", "W", "S1"}, "S1", 5, 500},
+		{"wild line beats symbol", []string{"W", "W", "W", "S2", "S1"}, "W", 3, 200},
+		{"full wild line", []string{"W", "W", "W", "W", "W"}, "W", 5, 2000},
+		{"too short", []string{"S1", "S1", "S2", "S1", "S1"}, "", 0, 0},
+		{"scatter does not start a line", []string{"B", "S1", "S1", "S1", "S1"}, "", 0, 0},
+		{"scatter breaks a line", []string{"S1", "B", "S1", "S1", "S1"}, "", 0, 0},
+		{"symbol without payouts", []string{"S3", "S3", "S3", "S3", "S3"}, "", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sym, count, val := s.matchLine(tt.symbols)
+			if sym != tt.wantSym || count != tt.wantCount || val != tt.wantVal {
+				t.Errorf("matchLine(%v) = %q, %d, %d; want %q, %d, %d",
+					tt.symbols, sym, count, val, tt.wantSym, tt.wantCount, tt.wantVal)
+			}
+		})
+	}
+}
+
+func TestEvaluateLines(t *testing.T) {
+	// Поле по барабанам: board[reel][row]. Средняя линия — S2 S2 S1 S1 S1, верхняя — S1 x5
+	board := [5][3]string{
+		{"S1", "S2", "S3"},
+		{"S1", "S2", "S8"},
+		{"S1", "S1", "S3"},
+		{"S1", "S1", "S8"},
+		{"S1", "S1", "S3"},
+	}
+	tests := []struct {
+		name  string
+		extra string
+		lines int
+		want  []model.LineWin
+	}{
+		{
+			name:  "left to right",
+			lines: 3,
+			want:  []model.LineWin{{Line: 2, Symbol: "S1", Count: 5, Payout: 500, Direction: "ltr"}},
+		},
+		{
+			name:  "fewer lines pay more per line",
+			lines: 2,
+			want:  []model.LineWin{{Line: 2, Symbol: "S1", Count: 5, Payout: 750, Direction: "ltr"}},
+		},
+		{
+			name:  "right to left",
+			extra: "line_pay_direction: rtl\n",
+			lines: 3,
+			want: []model.LineWin{
+				{Line: 1, Symbol: "S1", Count: 3, Payout: 20, Direction: "rtl"},
+				{Line: 2, Symbol: "S1", Count: 5, Payout: 500, Direction: "rtl"},
+			},
+		},
+		{
+			name:  "both ways pay a full line once",
+			extra: "line_pay_direction: both\n",
+			lines: 3,
+			want: []model.LineWin{
+				{Line: 1, Symbol: "S1", Count: 3, Payout: 20, Direction: "rtl"},
+				{Line: 2, Symbol: "S1", Count: 5, Payout: 500, Direction: "ltr"},
+			},
+		},
+		{
+			name:  "both ways pay a full line twice",
+			extra: "line_pay_direction: both\nline_both_ways_full_line: twice\n",
+			lines: 3,
+			want: []model.LineWin{
+				{Line: 1, Symbol: "S1", Count: 3, Payout: 20, Direction: "rtl"},
+				{Line: 2, Symbol: "S1", Count: 5, Payout: 500, Direction: "ltr"},
+				{Line: 2, Symbol: "S1", Count: 5, Payout: 500, Direction: "rtl"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServ(t, tt.extra)
+			got := s.EvaluateLines(board, model.LineSpin{Bet: 100, Lines: tt.lines})
+			assertWins(t, got, tt.want)
+		})
+	}
+}
+
+func TestEvaluateWays(t *testing.T) {
+	tests := []struct {
+		name  string
+		board [5][3]string
+		want  []model.LineWin
+	}{
+		{
+			// S1: 2 ячейки на 1-м барабане, 1 на 2-м, 3 на 3-м — 6 путей по 3 символа
+			name: "ways multiply per reel",
+			board: [5][3]string{
+				{"S1", "S1", "S3"},
+				{"S3", "S3", "S1"},
+				{"S1", "S1", "S1"},
+				{"S3", "S3", "S3"},
+				{"S3", "S3", "S3"},
+			},
+			want: []model.LineWin{{Symbol: "S1", Count: 3, Ways: 6, Payout: 12, Direction: "ltr"}},
+		},
+		{
+			name: "wild adds a way",
+			board: [5][3]string{
+				{"S1", "S1", "S3"},
+				{"W", "S3", "S1"},
+				{"S1", "S1", "S1"},
+				{"S3", "S3", "S3"},
+				{"S3", "S3", "S3"},
+			},
+			want: []model.LineWin{{Symbol: "S1", Count: 3, Ways: 12, Payout: 24, Direction: "ltr"}},
+		},
+		{
+			// Короткие пути символа не платят, если есть длиннее
+			name: "only the longest combination pays",
+			board: [5][3]string{
+				{"S1", "S3", "S3"},
+				{"S1", "S3", "S3"},
+				{"S1", "S1", "S3"},
+				{"S1", "S3", "S3"},
+				{"S3", "S3", "S3"},
+			},
+			want: []model.LineWin{{Symbol: "S1", Count: 4, Ways: 2, Payout: 20, Direction: "ltr"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestServ(t, "line_evaluator: ways\n")
+			got := s.EvaluateWays(tt.board, model.LineSpin{Bet: 100, Lines: 3})
+			assertWins(t, got, tt.want)
+		})
+	}
+}
+
+// assertWins сравнивает выигрыши без позиций ячеек
+func assertWins(t *testing.T, got, want []model.LineWin) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d wins %+v, want %d %+v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		g := got[i]
+		g.Positions = nil
+		if g.Line != want[i].Line || g.Symbol != want[i].Symbol || g.Count != want[i].Count ||
+			g.Ways != want[i].Ways || g.Payout != want[i].Payout || g.Direction != want[i].Direction {
+			t.Errorf("win %d = %+v, want %+v", i, g, want[i])
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package line
+
+import "errors"
+
+// checkNoActiveFeature проверяет, что игрок не находится в бонусной игре,
+// которую нужно доиграть через отдельный эндпоинт
+func (s *serv) checkNoActiveFeature() error {
+	holdAndWin, err := s.repo.GetHoldAndWinState()
+	if err != nil {
+		return errors.New("failed to get hold and win state")
+	}
+	if holdAndWin.Active {
+		return errors.New("hold and win bonus is in progress")
+	}
+
+	pickEm, err := s.repo.GetPickEmState()
+	if err != nil {
+		return errors.New("failed to get pick-em state")
+	}
+	if pickEm.Active {
+		return errors.New("pick-em bonus is in progress")
+	}
+
+	wheelState, err := s.repo.GetWheelState()
+	if err != nil {
+		return errors.New("failed to get wheel state")
+	}
+	if wheelState.Active {
+		return errors.New("wheel bonus is in progress")
+	}
+
+	gamble, err := s.repo.GetGambleState()
+	if err != nil {
+		return errors.New("failed to get gamble state")
+	}
+	if gamble.Active {
+		return errors.New("gamble is in progress: collect or gamble the win first")
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package line
+
+import (
+	"casino_test/internal/model"
+	"sort"
+)
+
+// newFreeSpinState состояние в начале бонуса: ставка, от которой платят фриспины, и уже выигранное в нём
+func newFreeSpinState(bet, lines, featureWin int) model.LineFreeSpinState {
+	return model.LineFreeSpinState{
+		Bet:            bet,
+		Lines:          lines,
+		FeatureWin:     featureWin,
+		WildReels:      map[int]int{},
+		SpinMultiplier: 1,
+	}
+}
+
+// stickyWildReels барабаны, которые остаются вайлдами до конца бонуса
+func (s *serv) stickyWildReels(fsState *model.LineFreeSpinState) []int {
+	if fsState == nil || !s.cfg.FreeSpinStickyWilds() {
+		return nil
+	}
+	reels := make([]int, 0, len(fsState.WildReels))
+	for reel := range fsState.WildReels {
+		reels = append(reels, reel)
+	}
+	sort.Ints(reels)
+	return reels
+}
+
+// assignWildMultipliers раздаёт множители вайлд-барабанам во фриспинах.
+// Липкий барабан сохраняет свой множитель, новый получает случайный по весам из конфига.
+// Возвращает множители в том же порядке, что и wildReels (x1 в базовой игре).
+func (s *serv) assignWildMultipliers(wildReels []int, fsState *model.LineFreeSpinState) []int {
+	mults := make([]int, len(wildReels))
+	for i, reel := range wildReels {
+		mults[i] = 1
+		if fsState == nil {
+			continue
+		}
+		if m, ok := fsState.WildReels[reel]; ok {
+			mults[i] = m
+			continue
+		}
+		mults[i] = s.randomWildMultiplier()
+		if s.cfg.FreeSpinStickyWilds() {
+			fsState.WildReels[reel] = mults[i]
+		}
+	}
+	return mults
+}
+
+// randomWildMultiplier выбирает множитель вайлда по весам; без настройки — x1
+func (s *serv) randomWildMultiplier() int {
+	weights := s.cfg.FreeSpinWildMultipliers()
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return 1
+	}
+
+	// Обходим множители по возрастанию, чтобы выбор не зависел от порядка обхода map
+	values := make([]int, 0, len(weights))
+	for m := range weights {
+		values = append(values, m)
+	}
+	sort.Ints(values)
+
+	n := s.rng.Intn(total)
+	for _, m := range values {
+		if n < weights[m] {
+			return m
+		}
+		n -= weights[m]
+	}
+	return 1
+}
+
+// applyWinMultipliers умножает выигрыши на множители вайлд-барабанов, через которые прошла
+// комбинация, и на общий множитель фриспина
+func (s *serv) applyWinMultipliers(board [5][3]string, wins []model.LineWin, wildReels, wildMults []int, spinMult int) {
+	reelMult := map[int]int{}
+	for i, reel := range wildReels {
+		reelMult[reel] = wildMults[i]
+	}
+
+	for i := range wins {
+		mult := spinMult
+		used := map[int]bool{}
+		for _, p := range wins[i].Positions {
+			if used[p.Reel] || board[p.Reel][p.Row] != "W" {
+				continue
+			}
+			used[p.Reel] = true
+			if m, ok := reelMult[p.Reel]; ok {
+				mult *= m
+			}
+		}
+		wins[i].Multiplier = mult
+		wins[i].Payout *= mult
+	}
+}
@@ -0,0 +1,138 @@
+package line
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Варианты ставки в игре на удвоение: цвет платит x2, масть — x4
+const (
+	gambleRed      = "red"
+	gambleBlack    = "black"
+	gambleHearts   = "hearts"
+	gambleDiamonds = "diamonds"
+	gambleClubs    = "clubs"
+	gambleSpades   = "spades"
+
+	colourMultiplier = 2
+	suitMultiplier   = 4
+)
+
+var (
+	gambleSuits = []string{gambleHearts, gambleDiamonds, gambleClubs, gambleSpades}
+	gambleRanks = []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "J", "Q", "K", "A"}
+)
+
+// offerGamble откладывает выигрыш спина для игры на удвоение.
+// Возвращает nil, если рисковать нечем или нельзя — тогда выигрыш зачисляется сразу.
+func (s *serv) offerGamble(win, bet int, allowed bool) (*model.GambleState, error) {
+	if !s.cfg.GambleEnabled() || !allowed || win <= 0 {
+		return nil, nil
+	}
+
+	state := model.GambleState{Active: true, Bet: bet, Win: win}
+	state.CanGamble = s.canGamble(state, colourMultiplier)
+	if err := s.repo.UpdateGambleState(state); err != nil {
+		return nil, errors.New("failed to save gamble state")
+	}
+	return &state, nil
+}
+
+// Gamble рискует несобранным выигрышем: угадан цвет — x2, масть — x4, ошибка — выигрыш сгорает
+func (s *serv) Gamble(ctx context.Context, choice string) (*model.GambleResult, error) {
+	state, err := s.repo.GetGambleState()
+	if err != nil {
+		return nil, errors.New("failed to get gamble state")
+	}
+	if !state.Active {
+		return nil, errors.New("no win to gamble")
+	}
+
+	multiplier, err := gambleMultiplier(choice)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canGamble(state, multiplier) {
+		return nil, errors.New("gamble limit reached: collect the win")
+	}
+
+	card := model.GambleCard{
+		Rank: gambleRanks[s.rng.Intn(len(gambleRanks))],
+		Suit: gambleSuits[s.rng.Intn(len(gambleSuits))],
+	}
+	won := choice == card.Suit || choice == suitColour(card.Suit)
+
+	if won {
+		state.Win *= multiplier
+		state.Step++
+		state.CanGamble = s.canGamble(state, colourMultiplier)
+	} else {
+		state = model.GambleState{}
+	}
+	if err := s.repo.UpdateGambleState(state); err != nil {
+		return nil, errors.New("failed to save gamble state")
+	}
+
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, errors.New("failed to get user balance")
+	}
+
+	return &model.GambleResult{
+		Choice:  choice,
+		Card:    card,
+		Won:     won,
+		State:   state,
+		Balance: balance,
+	}, nil
+}
+
+// Collect зачисляет несобранный выигрыш на баланс
+func (s *serv) Collect(ctx context.Context) (*model.GambleCollect, error) {
+	state, err := s.repo.GetGambleState()
+	if err != nil {
+		return nil, errors.New("failed to get gamble state")
+	}
+	if !state.Active {
+		return nil, errors.New("no win to collect")
+	}
+
+	balance, err := s.wallet.Credit(state.Win)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+	if err := s.repo.UpdateGambleState(model.GambleState{}); err != nil {
+		return nil, errors.New("failed to save gamble state")
+	}
+
+	return &model.GambleCollect{Amount: state.Win, Balance: balance}, nil
+}
+
+// canGamble проверяет, остались ли попытки и не превысит ли выигрыш лимит
+func (s *serv) canGamble(state model.GambleState, multiplier int) bool {
+	if state.Step >= s.cfg.GambleMaxSteps() {
+		return false
+	}
+	return state.Win*multiplier <= s.cfg.GambleLimitXBet()*state.Bet
+}
+
+// gambleMultiplier возвращает множитель для варианта ставки
+func gambleMultiplier(choice string) (int, error) {
+	switch choice {
+	case gambleRed, gambleBlack:
+		return colourMultiplier, nil
+	case gambleHearts, gambleDiamonds, gambleClubs, gambleSpades:
+		return suitMultiplier, nil
+	default:
+		return 0, errors.New("choice must be a colour (red, black) or a suit (hearts, diamonds, clubs, spades)")
+	}
+}
+
+// suitColour возвращает цвет масти
+func suitColour(suit string) string {
+	if suit == gambleHearts || suit == gambleDiamonds {
+		return gambleRed
+	}
+	return gambleBlack
+}
@@ -0,0 +1,154 @@
+package line
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"sort"
+)
+
+// Джекпот за заполненное денежными символами поле
+const grandJackpot = "grand"
+
+// moneyCells находит денежные символы на поле и раздаёт им значения
+func (s *serv) moneyCells(board [5][3]string, bet int) []model.MoneyCell {
+	cells := []model.MoneyCell{}
+	for r := 0; r < reels; r++ {
+		for row := 0; row < rows; row++ {
+			if board[r][row] == "M" {
+				cells = append(cells, s.randomMoneyCell(model.LinePosition{Reel: r, Row: row}, bet))
+			}
+		}
+	}
+	return cells
+}
+
+// startHoldAndWin запускает бонус, если денежных символов хватает; иначе возвращает nil
+func (s *serv) startHoldAndWin(cells []model.MoneyCell, bet int) (*model.HoldAndWinState, error) {
+	if len(cells) < s.cfg.HoldAndWinTrigger() {
+		return nil, nil
+	}
+
+	state := model.HoldAndWinState{
+		Active:      true,
+		Bet:         bet,
+		RespinsLeft: s.cfg.HoldAndWinRespins(),
+		Cells:       cells,
+	}
+	if err := s.repo.UpdateHoldAndWinState(state); err != nil {
+		return nil, errors.New("failed to save hold and win state")
+	}
+	return &state, nil
+}
+
+// HoldAndWinRespin делает один респин бонуса hold and win.
+// Пустые ячейки разыгрываются заново, выпавшие денежные символы залипают и сбрасывают счётчик респинов.
+// Когда респины кончаются или поле заполнено, собранные значения и джекпоты выплачиваются.
+func (s *serv) HoldAndWinRespin(ctx context.Context) (*model.HoldAndWinResult, error) {
+	state, err := s.repo.GetHoldAndWinState()
+	if err != nil {
+		return nil, errors.New("failed to get hold and win state")
+	}
+	if !state.Active {
+		return nil, errors.New("no hold and win bonus in progress")
+	}
+
+	var occupied [reels][rows]bool
+	for _, cell := range state.Cells {
+		occupied[cell.Reel][cell.Row] = true
+	}
+
+	var newCells []model.MoneyCell
+	for r := 0; r < reels; r++ {
+		for row := 0; row < rows; row++ {
+			if occupied[r][row] || s.rng.Float64() >= s.cfg.HoldAndWinLandChance() {
+				continue
+			}
+			newCells = append(newCells, s.randomMoneyCell(model.LinePosition{Reel: r, Row: row}, state.Bet))
+		}
+	}
+
+	if len(newCells) > 0 {
+		state.Cells = append(state.Cells, newCells...)
+		state.RespinsLeft = s.cfg.HoldAndWinRespins()
+	} else {
+		state.RespinsLeft--
+	}
+
+	result := &model.HoldAndWinResult{NewCells: newCells}
+	result.Grand = len(state.Cells) == reels*rows
+	result.Finished = result.Grand || state.RespinsLeft == 0
+
+	if result.Finished {
+		total := 0
+		for _, cell := range state.Cells {
+			total += cell.Value
+		}
+		if result.Grand {
+			total += s.cfg.MoneyJackpots()[grandJackpot] * state.Bet / 100
+		}
+		result.TotalPayout = s.ApplyMaxPayout(total, state.Bet, s.cfg.MaxWinXBet())
+		state.Active = false
+	}
+
+	balance, err := s.wallet.Credit(result.TotalPayout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	if err := s.repo.UpdateHoldAndWinState(state); err != nil {
+		return nil, errors.New("failed to save hold and win state")
+	}
+
+	result.State = state
+	result.Balance = balance
+	return result, nil
+}
+
+// randomMoneyCell выбирает значение денежного символа: сумму (в сотых долях ставки) или джекпот
+func (s *serv) randomMoneyCell(pos model.LinePosition, bet int) model.MoneyCell {
+	values := s.cfg.MoneyValues()
+	jackpots := s.cfg.MoneyJackpotWeights()
+
+	total := 0
+	for _, w := range values {
+		total += w
+	}
+	for _, w := range jackpots {
+		total += w
+	}
+	cell := model.MoneyCell{LinePosition: pos}
+	if total <= 0 {
+		return cell
+	}
+
+	// Обходим ключи по порядку, чтобы выбор не зависел от порядка обхода map
+	valueKeys := make([]int, 0, len(values))
+	for v := range values {
+		valueKeys = append(valueKeys, v)
+	}
+	sort.Ints(valueKeys)
+	jackpotKeys := make([]string, 0, len(jackpots))
+	for name := range jackpots {
+		jackpotKeys = append(jackpotKeys, name)
+	}
+	sort.Strings(jackpotKeys)
+
+	n := s.rng.Intn(total)
+	for _, v := range valueKeys {
+		if n < values[v] {
+			cell.Value = v * bet / 100
+			return cell
+		}
+		n -= values[v]
+	}
+	for _, name := range jackpotKeys {
+		if n < jackpots[name] {
+			cell.Jackpot = name
+			cell.Value = s.cfg.MoneyJackpots()[name] * bet / 100
+			return cell
+		}
+		n -= jackpots[name]
+	}
+	return cell
+}
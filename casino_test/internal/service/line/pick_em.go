@@ -0,0 +1,115 @@
+package line
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// startPickEm запускает pick-em вместо фриспинов, если так настроены скаттеры.
+// Призы раскладываются по ячейкам сразу и сохраняются — выбор игрока на них уже не влияет.
+func (s *serv) startPickEm(res *model.SpinResult, bet int) (*model.PickEmState, error) {
+	if s.cfg.ScatterFeature() != config.ScatterFeaturePickEm || res.AwardedFreeSpins == 0 {
+		return nil, nil
+	}
+	res.AwardedFreeSpins = 0
+
+	prizes := make([]model.PickEmPrize, len(s.cfg.PickEmPrizes()))
+	for i, p := range s.cfg.PickEmPrizes() {
+		prizes[i] = model.PickEmPrize{Type: p.Type, Value: p.Value}
+	}
+	s.rng.Shuffle(len(prizes), func(i, j int) {
+		prizes[i], prizes[j] = prizes[j], prizes[i]
+	})
+
+	state := model.PickEmState{
+		Active:     true,
+		Bet:        bet,
+		Prizes:     prizes,
+		Picks:      []model.PickEmPick{},
+		PicksLeft:  s.cfg.PickEmPicks(),
+		Multiplier: 1,
+	}
+	if err := s.repo.UpdatePickEmState(state); err != nil {
+		return nil, errors.New("failed to save pick-em state")
+	}
+	return &state, nil
+}
+
+// PickEmPick открывает ячейку pick-em. Игра заканчивается, когда выпал collect,
+// кончились выборы или ячейки — тогда собранное умножается на множитель и выплачивается.
+func (s *serv) PickEmPick(ctx context.Context, index int) (*model.PickEmResult, error) {
+	state, err := s.repo.GetPickEmState()
+	if err != nil {
+		return nil, errors.New("failed to get pick-em state")
+	}
+	if !state.Active {
+		return nil, errors.New("no pick-em bonus in progress")
+	}
+	if index < 0 || index >= len(state.Prizes) {
+		return nil, fmt.Errorf("pick index must be between 0 and %d", len(state.Prizes)-1)
+	}
+	for _, p := range state.Picks {
+		if p.Index == index {
+			return nil, errors.New("this cell is already picked")
+		}
+	}
+
+	pick := model.PickEmPick{Index: index, Prize: state.Prizes[index]}
+	state.Picks = append(state.Picks, pick)
+	state.PicksLeft--
+
+	collect := false
+	switch pick.Prize.Type {
+	case config.PickEmPrizeCredit:
+		state.Credits += pick.Prize.Value
+	case config.PickEmPrizeMultiplier:
+		state.Multiplier *= pick.Prize.Value
+	case config.PickEmPrizeExtraPick:
+		state.PicksLeft += pick.Prize.Value
+	case config.PickEmPrizeCollect:
+		collect = true
+	}
+
+	result := &model.PickEmResult{Pick: pick}
+	result.Finished = collect || state.PicksLeft == 0 || len(state.Picks) == len(state.Prizes)
+
+	if result.Finished {
+		// Кредиты — в сотых долях ставки, как у денежных символов и таблиц выплат
+		total := state.Credits * state.Multiplier * state.Bet / 100
+		result.TotalPayout = s.ApplyMaxPayout(total, state.Bet, s.cfg.MaxWinXBet())
+		result.Unpicked = unpickedCells(state)
+		state.Active = false
+	}
+
+	balance, err := s.wallet.Credit(result.TotalPayout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	if err := s.repo.UpdatePickEmState(state); err != nil {
+		return nil, errors.New("failed to save pick-em state")
+	}
+
+	result.State = state
+	result.Balance = balance
+	return result, nil
+}
+
+// unpickedCells раскрывает содержимое неоткрытых ячеек
+func unpickedCells(state model.PickEmState) []model.PickEmPick {
+	picked := make(map[int]bool, len(state.Picks))
+	for _, p := range state.Picks {
+		picked[p.Index] = true
+	}
+
+	cells := []model.PickEmPick{}
+	for i, prize := range state.Prizes {
+		if !picked[i] {
+			cells = append(cells, model.PickEmPick{Index: i, Prize: prize})
+		}
+	}
+	return cells
+}
@@ -4,17 +4,28 @@ import (
 	"casino_test/internal/config"
 	"casino_test/internal/repository"
 	"casino_test/internal/service"
+	"casino_test/pkg/rng"
 )
 
 type serv struct {
-	cfg  config.LineConfig
-	repo repository.LineRepository
+	cfg      config.LineConfig
+	wheelCfg config.WheelConfig
+	betCfg   config.BetConfig
+	jackpots service.JackpotService
+	repo     repository.LineRepository
+	wallet   repository.WalletRepository
+	rng      rng.RNG
 }
 
 // NewLine Создать новый слот 5x3
-func NewLineService(cfg config.LineConfig, repo repository.LineRepository) service.LineService {
+func NewLineService(cfg config.LineConfig, wheelCfg config.WheelConfig, betCfg config.BetConfig, repo repository.LineRepository, wallet repository.WalletRepository, jackpots service.JackpotService, r rng.RNG) service.LineService {
 	return &serv{
-		cfg:  cfg,
-		repo: repo,
+		cfg:      cfg,
+		wheelCfg: wheelCfg,
+		betCfg:   betCfg,
+		repo:     repo,
+		wallet:   wallet,
+		jackpots: jackpots,
+		rng:      r,
 	}
 }
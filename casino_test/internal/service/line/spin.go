@@ -1,49 +1,26 @@
 package line
 
 import (
+	"casino_test/internal/config"
 	"casino_test/internal/model"
 	"context"
 	"errors"
-	"math/rand"
-)
-
-var (
-	// Линии выплат
-	playLines = [][]int{
-		{1, 1, 1, 1, 1},
-		{0, 0, 0, 0, 0},
-		{2, 2, 2, 2, 2},
-		{0, 1, 2, 1, 0},
-		{2, 1, 0, 1, 2},
-		{0, 0, 1, 0, 0},
-		{2, 2, 1, 2, 2},
-		{1, 0, 0, 0, 1},
-		{1, 2, 2, 2, 1},
-		{1, 0, 1, 0, 1},
-		{1, 2, 1, 2, 1},
-		{0, 1, 0, 1, 0},
-		{2, 1, 2, 1, 2},
-		{1, 1, 0, 1, 1},
-		{1, 1, 2, 1, 1},
-		{0, 1, 1, 1, 2},
-		{2, 1, 1, 1, 0},
-		{0, 0, 1, 2, 2},
-		{2, 2, 1, 0, 0},
-		{1, 0, 2, 0, 1},
-	}
+	"fmt"
+	"sort"
 )
 
 const (
 	// Барабаны
-	reels = 5
+	reels = config.LineReels
 	// Линии
-	rows = 3
+	rows = config.LineRows
 	// Стоимость покупки бонуса (x ставки)
 	buyBonusMultiplier = 100
-	// Максимальная выплата в кратности ставки
-	maxPayoutMultiplier = 10000
 )
 
+// GameID ID игры в реестре (по нему джекпоты выбирают порог скаттеров)
+const GameID = "line"
+
 // Spin выполняет спин с учётом баланса и фриспинов
 func (s *serv) Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinResult, error) {
 	// Валидация ставки
@@ -51,6 +28,23 @@ func (s *serv) Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinRes
 	if spinReq.Bet <= 0 || spinReq.Bet%2 != 0 {
 		return nil, errors.New("bet must be positive and even")
 	}
+	if !s.betCfg.IsAllowedBet(spinReq.Bet) {
+		return nil, errors.New("bet is not on the bet ladder")
+	}
+	// Количество линий: 0 — играем все линии из конфига
+	totalLines := len(s.cfg.Paylines())
+	if spinReq.Lines == 0 || s.cfg.Evaluator() == config.LineEvaluatorWays {
+		// В режиме ways выбор линий не действует — играют все пути
+		spinReq.Lines = totalLines
+	}
+	if spinReq.Lines < 1 || spinReq.Lines > totalLines {
+		return nil, fmt.Errorf("lines must be between 1 and %d", totalLines)
+	}
+
+	// Пока идёт бонусная игра, обычные спины запрещены
+	if err := s.checkNoActiveFeature(); err != nil {
+		return nil, err
+	}
 
 	// Получаем текущее количество фриспинов
 	countFreeSpins, err := s.repo.GetFreeSpinCount()
@@ -59,21 +53,14 @@ func (s *serv) Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinRes
 	}
 	// Инициализируем результат (чтобы безопасно устанавливать InFreeSpin)
 	var res *model.SpinResult
+	// Состояние модификаторов бонуса (липкие вайлды, множители) — только во фриспинах
+	var fsState *model.LineFreeSpinState
 
 	// платный или фриспин?
 	if countFreeSpins == 0 {
-		userBalance, err := s.repo.GetBalance()
-		if err != nil {
-			return nil, errors.New("failed to get user balance")
-		}
-		if userBalance < spinReq.Bet {
-			return res, errors.New("not enough balance")
-		}
-
-		// Списание должно быть атомарным! Здесь просто пример — в реале делайте в транзакции.
-		userBalance -= spinReq.Bet
-		if err := s.repo.UpdateBalance(userBalance); err != nil {
-			return nil, errors.New("failed to update user balance")
+		// Кошелёк общий для всех игр — списываем атомарно
+		if _, err := s.wallet.Debit(spinReq.Bet); err != nil {
+			return nil, err
 		}
 	} else {
 		// фриспин — уменьшить счётчик сразу
@@ -81,27 +68,92 @@ func (s *serv) Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinRes
 		if err := s.repo.UpdateFreeSpinCount(countFreeSpins - 1); err != nil {
 			return nil, errors.New("failed to update count free spins")
 		}
+		state, err := s.repo.GetFreeSpinState()
+		if err != nil {
+			return nil, errors.New("failed to get free spin state")
+		}
+		fsState = &state
+		// Фриспины ничего не списывают, поэтому платят от ставки и линий, с которыми начался бонус
+		spinReq.Bet = state.Bet
+		spinReq.Lines = state.Lines
 	}
 
 	// делаем спин
-	res, err = s.SpinOnce(ctx, spinReq)
+	res, err = s.SpinOnce(ctx, spinReq, fsState)
 	if err != nil {
 		return nil, err
 	}
+	if res.MaxWinReached {
+		// Лимит выигрыша достигнут — раунд окончен, новые фриспины не начисляются
+		res.AwardedFreeSpins = 0
+	}
+
+	// Платный спин пополняет джекпоты и может их выиграть (выигрыш сразу идёт в кошелёк)
+	jackpots := []model.JackpotWin{}
+	if fsState == nil {
+		jackpots, err = s.jackpots.Contribute(ctx, GameID, spinReq.Bet, res.ScatterCount)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// В режиме pick-em скаттеры запускают бонусную игру вместо фриспинов
+	pickEm, err := s.startPickEm(res, spinReq.Bet)
+	if err != nil {
+		return nil, err
+	}
+	// ...или колесо фортуны
+	wheelView, err := s.startWheel(res, spinReq.Bet)
+	if err != nil {
+		return nil, err
+	}
+
+	if fsState != nil {
+		// Следующий фриспин — с выросшим множителем и теми же липкими вайлдами
+		fsState.SpinMultiplier += s.cfg.FreeSpinMultiplierStep()
+		fsState.FeatureWin += res.TotalPayout
+		if err := s.repo.UpdateFreeSpinState(*fsState); err != nil {
+			return nil, errors.New("failed to update free spin state")
+		}
+		if res.MaxWinReached {
+			// Оставшиеся фриспины сгорают
+			if err := s.repo.UpdateFreeSpinCount(0); err != nil {
+				return nil, errors.New("failed to update count free spins")
+			}
+		}
+	} else if res.AwardedFreeSpins > 0 {
+		// Бонус начинается заново: от ставки этого спина, без липких вайлдов и с множителем x1.
+		// Выигрыш запускающего спина входит в лимит бонуса
+		if err := s.repo.UpdateFreeSpinState(newFreeSpinState(spinReq.Bet, spinReq.Lines, res.TotalPayout)); err != nil {
+			return nil, errors.New("failed to reset free spin state")
+		}
+	}
 
 	// Если это был фриспин, сохраняем флаг
 	if countFreeSpins > 0 {
 		res.InFreeSpin = true
 	}
 
-	// обновляем баланс
-	balance, err := s.repo.GetBalance()
+	// Достаточно денежных символов — запускаем hold and win
+	holdAndWin, err := s.startHoldAndWin(res.MoneyCells, spinReq.Bet)
 	if err != nil {
-		return nil, errors.New("failed to get user balance")
+		return nil, err
 	}
-	balance += res.TotalPayout
 
-	err = s.repo.UpdateBalance(balance)
+	// Выигрыш платного спина можно рискнуть — тогда он ждёт в репозитории до сбора или проигрыша.
+	// Во фриспинах не предлагаем: иначе неразыгранный выигрыш блокирует остаток бонуса
+	allowGamble := !res.InFreeSpin && holdAndWin == nil && pickEm == nil && wheelView == nil
+	gamble, err := s.offerGamble(res.TotalPayout, spinReq.Bet, allowGamble)
+	if err != nil {
+		return nil, err
+	}
+
+	// обновляем баланс; выигрыш на удвоении зачисляется при сборе
+	payout := res.TotalPayout
+	if gamble != nil {
+		payout = 0
+	}
+	balance, err := s.wallet.Credit(payout)
 	if err != nil {
 		return nil, errors.New("failed to update user balance")
 	}
@@ -125,31 +177,68 @@ func (s *serv) Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinRes
 	}
 
 	return &model.SpinResult{
+		InitialBoard:     res.InitialBoard,
 		Board:            res.Board,
+		Tumbles:          res.Tumbles,
+		WildReels:        res.WildReels,
+		WildMultipliers:  res.WildMultipliers,
+		SpinMultiplier:   res.SpinMultiplier,
 		LineWins:         res.LineWins,
 		ScatterCount:     res.ScatterCount,
+		ScatterPositions: res.ScatterPositions,
 		ScatterPayout:    res.ScatterPayout,
 		AwardedFreeSpins: res.AwardedFreeSpins,
 		TotalPayout:      res.TotalPayout,
 		Balance:          balance,
 		FreeSpinCount:    freeCount,
 		InFreeSpin:       res.InFreeSpin,
+		MaxWinReached:    res.MaxWinReached,
+		Lines:            spinReq.Lines,
+		MoneyCells:       res.MoneyCells,
+		HoldAndWin:       holdAndWin,
+		Gamble:           gamble,
+		PickEm:           pickEm,
+		Wheel:            wheelView,
+		Jackpots:         jackpots,
 	}, nil
 }
 
-// SpinOnce выполняет один спин (возвращает единый SpinResult)
-func (s *serv) SpinOnce(ctx context.Context, spinReq model.LineSpin) (*model.SpinResult, error) {
-	board, err := s.GenerateBoard()
+// SpinOnce выполняет один спин (возвращает единый SpinResult).
+// fsState — состояние модификаторов бонуса во фриспине, nil в базовой игре; обновляется на месте
+func (s *serv) SpinOnce(ctx context.Context, spinReq model.LineSpin, fsState *model.LineFreeSpinState) (*model.SpinResult, error) {
+	board, wildReels, err := s.GenerateBoard(fsState != nil, s.stickyWildReels(fsState))
 	if err != nil {
 		return nil, err
 	}
+	wildMults := s.assignWildMultipliers(wildReels, fsState)
+	spinMult := 1
+	if fsState != nil {
+		spinMult = fsState.SpinMultiplier
+	}
+	initialBoard := board
+
+	// line wins
+	lineWins := s.evaluate(board, spinReq)
+	s.applyWinMultipliers(board, lineWins, wildReels, wildMults, spinMult)
+	var lineTotal int
+	for _, w := range lineWins {
+		lineTotal += w.Payout
+	}
+
+	// В режиме каскадов выигрышные символы уходят, сверху падают новые — до тех пор, пока есть выигрыши
+	var tumbles []model.LineTumbleStep
+	if s.cfg.TumbleEnabled() {
+		board, tumbles, lineTotal = s.resolveTumbles(board, lineWins, spinReq, wildReels, wildMults, spinMult)
+	}
 
-	// count scatters
+	// count scatters (на итоговом поле — в каскадах скаттер может упасть сверху)
 	scatters := 0
+	var scatterPositions []model.LinePosition
 	for r := 0; r < reels; r++ {
 		for c := 0; c < rows; c++ {
 			if board[r][c] == "B" {
 				scatters++
+				scatterPositions = append(scatterPositions, model.LinePosition{Reel: r, Row: c})
 			}
 		}
 	}
@@ -162,14 +251,14 @@ func (s *serv) SpinOnce(ctx context.Context, spinReq model.LineSpin) (*model.Spi
 		}
 	}
 
-	// line wins
-	lineWins := s.EvaluateLines(board, spinReq)
-	var lineTotal int
-	for _, w := range lineWins {
-		lineTotal += w.Payout
+	// Лимит действует на весь бонус: во фриспинах можно выиграть только то, что от него осталось
+	winCap := s.cfg.MaxWinXBet() * spinReq.Bet
+	if fsState != nil {
+		winCap = max(winCap-fsState.FeatureWin, 0)
 	}
-
-	total := s.ApplyMaxPayout(lineTotal+scatterPayout, spinReq.Bet, maxPayoutMultiplier)
+	win := lineTotal + scatterPayout
+	total := min(win, winCap)
+	maxWinReached := win > 0 && win >= winCap
 
 	awarded := 0
 	if scatters >= 3 {
@@ -178,41 +267,60 @@ func (s *serv) SpinOnce(ctx context.Context, spinReq model.LineSpin) (*model.Spi
 		}
 	}
 
+	// Денежные символы получают значения на итоговом поле
+	moneyCells := s.moneyCells(board, spinReq.Bet)
+
+	// Позиции скаттеров отдаём, только если они что-то принесли
+	if scatterPayout == 0 && awarded == 0 {
+		scatterPositions = nil
+	}
+
 	return &model.SpinResult{
+		InitialBoard:     initialBoard,
 		Board:            board,
+		Tumbles:          tumbles,
+		WildReels:        wildReels,
+		WildMultipliers:  wildMults,
+		SpinMultiplier:   spinMult,
 		LineWins:         lineWins,
 		ScatterCount:     scatters,
+		ScatterPositions: scatterPositions,
 		ScatterPayout:    scatterPayout,
 		AwardedFreeSpins: awarded,
 		TotalPayout:      total,
 		Balance:          0,
+		MaxWinReached:    maxWinReached,
+		MoneyCells:       moneyCells,
 	}, nil
 }
 
-// GenerateBoard генерирует игровое поле матрицы 5x3
-func (s *serv) GenerateBoard() ([5][3]string, error) {
+// GenerateBoard генерирует игровое поле матрицы 5x3.
+// sticky — барабаны, оставшиеся вайлдами с прошлых фриспинов.
+// Возвращает также номера барабанов (по возрастанию), целиком ставших вайлдами
+func (s *serv) GenerateBoard(freeSpin bool, sticky []int) ([5][3]string, []int, error) {
 	var board [5][3]string
 
-	countFreeSpins, err := s.repo.GetFreeSpinCount()
-	if err != nil {
-		return board, errors.New("failed to get count free spins")
-	}
-
 	// Добавляем вайлды только на центральные 3 барабана (индексы 1,2,3)
 	wildReels := map[int]bool{}
-	if countFreeSpins > 0 {
-		// ГАРАНТИРОВАННО хотя бы один Wild каждый спин бонуски
-		guaranteedReel := 1 + rand.Intn(3) // 1, 2 или 3 → барабаны 2,3,4
+	for _, reel := range sticky {
+		wildReels[reel] = true
+	}
+	if freeSpin {
+		// ГАРАНТИРОВАННО хотя бы один Wild каждый спин бонуски (липкие тоже считаются)
+		guaranteedReel := 1 + s.rng.Intn(3) // 1, 2 или 3 → барабаны 2,3,4
+		if len(sticky) > 0 {
+			guaranteedReel = sticky[0]
+		}
 		wildReels[guaranteedReel] = true
 		// Остальные два барабана могут тоже стать Wild с шансом 6%
 		for reel := 1; reel <= 3; reel++ {
-			if reel != guaranteedReel && rand.Float64() < s.cfg.WildChance() {
+			if reel != guaranteedReel && s.rng.Float64() < s.cfg.WildChance() {
 				wildReels[reel] = true
 			}
 		}
 	} else { // Обычная игра — обычный шанс 6% на каждый центральный барабан
 		for reel := 1; reel <= 3; reel++ {
-			if rand.Float64() < s.cfg.WildChance() {
+			if s.rng.Float64() < s.cfg.WildChance() {
 				wildReels[reel] = true
 			}
 		}
@@ -231,147 +339,227 @@ func (s *serv) GenerateBoard() ([5][3]string, error) {
 				continue
 			}
 
-			var sym string
-			if hasScatter[r] {
-				// На этом барабане уже есть скаттер → больше нельзя
-				sym = s.RandomWeightedNoScatter(symbolWeights)
-			} else {
-				// Обычный ролл, скаттер ещё разрешён
-				sym = s.RandomWeighted(symbolWeights)
-			}
-
-			board[r][row] = sym
+			board[r][row] = s.randomReelSymbol(symbolWeights, &hasScatter[r])
+		}
+	}
 
-			// Если только что выпал скаттер — помечаем барабан
-			if sym == "B" {
-				hasScatter[r] = true
-			}
+	wilds := make([]int, 0, reels)
+	for r := 0; r < reels; r++ {
+		if wildReels[r] {
+			wilds = append(wilds, r)
 		}
 	}
-	return board, nil
+	return board, wilds, nil
 }
 
-// EvaluateLines выполняет оценку выигрышных линий
+// evaluate считает выигрыши способом, выбранным в конфиге (линии или пути)
+func (s *serv) evaluate(board [5][3]string, spinReq model.LineSpin) []model.LineWin {
+	if s.cfg.Evaluator() == config.LineEvaluatorWays {
+		return s.EvaluateWays(board, spinReq)
+	}
+	return s.EvaluateLines(board, spinReq)
+}
+
+// EvaluateLines выполняет оценку выигрышных линий (только первых spinReq.Lines линий)
+// в направлениях, заданных в конфиге
 func (s *serv) EvaluateLines(board [5][3]string, spinReq model.LineSpin) []model.LineWin {
 	var wins []model.LineWin
-	for i, line := range playLines {
-		symbols := make([]string, reels)
-		for r := 0; r < 5; r++ {
-			symbols[r] = board[r][line[r]]
-		}
+	for i, line := range s.cfg.Paylines()[:spinReq.Lines] {
+		for _, dir := range s.payDirections() {
+			// Символы линии в порядке чтения: для справа налево — с последнего барабана
+			order := reelOrder(dir)
+			symbols := make([]string, reels)
+			for k, r := range order {
+				symbols[k] = board[r][line[r]]
+			}
 
-		// Пропускаем линии, где первый символ — скаттер
-		if symbols[0] == "B" {
-			continue
-		}
+			base, count, val := s.matchLine(symbols)
+			if base == "" {
+				continue
+			}
+			// Полная линия читается одинаково в обе стороны — при «once» платим её только слева направо
+			if dir == config.PayDirectionRightToLeft && count == reels && s.skipFullLineRightToLeft() {
+				continue
+			}
 
-		// Находим базовый символ (не W и не B)
-		var base string
-		for _, sym := range symbols {
-			if sym != "W" && sym != "B" {
-				base = sym
-				break
+			// Ячейки комбинации — первые count символов линии в порядке чтения
+			positions := make([]model.LinePosition, count)
+			for k := 0; k < count; k++ {
+				positions[k] = model.LinePosition{Reel: order[k], Row: line[order[k]]}
+			}
+			win := model.LineWin{
+				Line:      i + 1,
+				Symbol:    base,
+				Count:     count,
+				Payout:    s.linePayout(val, spinReq),
+				Positions: positions,
+				Direction: dir,
 			}
+			wins = append(wins, win)
 		}
-		if base == "" {
-			continue
+	}
+	return wins
+}
+
+// matchLine выбирает лучшую выплату линии («best pay»): либо вайлды подряд от начала линии
+// по таблице вайлдов, либо базовый символ с подстановкой вайлдов.
+// Возвращает символ (W — если платят одни вайлды), длину комбинации и значение из таблицы выплат.
+// Пустой символ — линия не платит.
+func (s *serv) matchLine(symbols []string) (string, int, int) {
+	// Пропускаем линии, где первый символ — скаттер
+	if symbols[0] == "B" {
+		return "", 0, 0
+	}
+
+	// Вайлды подряд от начала линии
+	wildCount := 0
+	for _, sym := range symbols {
+		if sym != "W" {
+			break
 		}
+		wildCount++
+	}
+	wildVal := s.cfg.WildPayoutTable()[wildCount]
 
-		// Считаем последовательность base + W с первого барабана
-		count := 0
-		for _, sym := range symbols {
-			if sym == base || sym == "W" {
-				count++
-			} else {
-				break
-			}
+	base, count, baseVal := s.matchBaseSymbol(symbols)
+	// При равной выплате оставляем базовый символ — его комбинация длиннее
+	if wildVal > baseVal {
+		return "W", wildCount, wildVal
+	}
+	if base == "" {
+		return "", 0, 0
+	}
+	return base, count, baseVal
+}
+
+// matchBaseSymbol находит базовый символ линии (с подстановкой вайлдов) и длину комбинации от начала
+func (s *serv) matchBaseSymbol(symbols []string) (string, int, int) {
+	// Находим базовый символ (не W и не B)
+	var base string
+	for _, sym := range symbols {
+		if sym != "W" && sym != "B" {
+			base = sym
+			break
 		}
+	}
+	if base == "" {
+		return "", 0, 0
+	}
 
-		// Определяем минимальное количество символов для выплаты
-		minCount := 3
-		for c := range s.cfg.PayoutTable()[base] {
-			if c < minCount {
-				minCount = c // обновится до 2 для S8
-			}
+	// Считаем последовательность base + W от начала линии
+	count := 0
+	for _, sym := range symbols {
+		if sym == base || sym == "W" {
+			count++
+		} else {
+			break
 		}
+	}
 
-		if count >= minCount {
-			if payTable, ok := s.cfg.PayoutTable()[base]; ok {
-				if val, ok := payTable[count]; ok {
-					win := model.LineWin{
-						Line:   i + 1,
-						Symbol: base,
-						Count:  count,
-						Payout: val * spinReq.Bet / 100,
-					}
-					wins = append(wins, win)
-				}
-			}
+	// Определяем минимальное количество символов для выплаты
+	minCount := 3
+	for c := range s.cfg.PayoutTable()[base] {
+		if c < minCount {
+			minCount = c // обновится до 2 для S8
 		}
 	}
-	return wins
+	if count < minCount {
+		return "", 0, 0
+	}
+	val, ok := s.cfg.PayoutTable()[base][count]
+	if !ok {
+		return "", 0, 0
+	}
+	return base, count, val
+}
+
+// linePayout считает выплату по линии.
+// Ставка делится на сыгранные линии поровну, а таблица выплат задана в сотых долях
+// ставки на все линии конфига. Поэтому выплата зависит только от ставки на линию
+// (bet / lines), а при игре на всех линиях получается прежнее val * bet / 100.
+// Ставку на линию не округляем — умножаем до деления, чтобы не терять копейки.
+func (s *serv) linePayout(val int, spinReq model.LineSpin) int {
+	return val * spinReq.Bet * len(s.cfg.Paylines()) / (spinReq.Lines * 100)
+}
+
+// randomReelSymbol выбирает символ для ячейки барабана: не больше одного скаттера на барабан
+func (s *serv) randomReelSymbol(symbolWeights map[string]int, hasScatter *bool) string {
+	var sym string
+	if *hasScatter {
+		// На этом барабане уже есть скаттер → больше нельзя
+		sym = s.RandomWeightedNoScatter(symbolWeights)
+	} else {
+		// Обычный ролл, скаттер ещё разрешён
+		sym = s.RandomWeighted(symbolWeights)
+	}
+
+	// Если только что выпал скаттер — помечаем барабан
+	if sym == "B" {
+		*hasScatter = true
+	}
+	return sym
 }
 
 // RandomWeighted выполняет взвешенный случайный выбор символа
 func (s *serv) RandomWeighted(symbolWeights map[string]int) string {
+	symbols := sortedSymbols(symbolWeights)
 	total := 0
 	for _, w := range symbolWeights {
 		total += w
 	}
 	if total <= 0 {
-		for s := range symbolWeights {
-			return s
+		if len(symbols) > 0 {
+			return symbols[0]
 		}
 		return ""
 	}
-	r := rand.Intn(total)
-	for s, w := range symbolWeights {
-		if r < w {
-			return s
+	r := s.rng.Intn(total)
+	for _, sym := range symbols {
+		if r < symbolWeights[sym] {
+			return sym
 		}
-		r -= w
-	}
-	for s := range symbolWeights {
-		return s
+		r -= symbolWeights[sym]
 	}
-	return ""
+	return symbols[0]
 }
 
 // RandomWeightedNoScatter — выбирает символ по весам, но полностью исключает скаттер "B"
 func (s *serv) RandomWeightedNoScatter(symbolWeights map[string]int) string {
-	total := 0
-	for sym, w := range symbolWeights {
+	symbols := make([]string, 0, len(symbolWeights))
+	for _, sym := range sortedSymbols(symbolWeights) {
 		if sym != "B" { // полностью игнорируем скаттер
-			total += w
+			symbols = append(symbols, sym)
 		}
 	}
+	total := 0
+	for _, sym := range symbols {
+		total += symbolWeights[sym]
+	}
 	if total <= 0 {
-		for sym := range symbolWeights {
-			if sym != "B" {
-				return sym
-			}
+		if len(symbols) > 0 {
+			return symbols[0]
 		}
 		return ""
 	}
-	r := rand.Intn(total)
-	current := 0
-	for sym, w := range symbolWeights {
-		if sym == "B" {
-			continue
-		}
-		if r < current+w {
+	r := s.rng.Intn(total)
+	for _, sym := range symbols {
+		if r < symbolWeights[sym] {
 			return sym
 		}
-		current += w
+		r -= symbolWeights[sym]
 	}
+	return symbols[0]
+}
 
-	// fallback
+// sortedSymbols символы по алфавиту: выбор по весам не должен зависеть от порядка обхода map,
+// иначе ГСЧ с фиксированным seed не повторяет спин
+func sortedSymbols(symbolWeights map[string]int) []string {
+	symbols := make([]string, 0, len(symbolWeights))
 	for sym := range symbolWeights {
-		if sym != "B" {
-			return sym
-		}
+		symbols = append(symbols, sym)
 	}
-	return ""
+	sort.Strings(symbols)
+	return symbols
 }
 
 // ApplyMaxPayout применяет лимит по максимальному выигрышу
@@ -0,0 +1,65 @@
+package line
+
+import (
+	"casino_test/internal/model"
+	"casino_test/pkg/rng"
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSpinOnceSeededIsReproducible(t *testing.T) {
+	a, b := newTestServ(t, ""), newTestServ(t, "")
+	a.rng, b.rng = rng.NewSeeded(7), rng.NewSeeded(7)
+	spin := model.LineSpin{Bet: 100, Lines: 3}
+
+	for i := 0; i < 100; i++ {
+		resA, err := a.SpinOnce(context.Background(), spin, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resB, err := b.SpinOnce(context.Background(), spin, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resA, resB) {
+			t.Fatalf("spin %d differs with the same seed:\n%+v\n%+v", i, resA, resB)
+		}
+	}
+}
+
+func TestSpinOnceCapsWholeFeature(t *testing.T) {
+	tests := []struct {
+		name       string
+		featureWin int
+		wantCap    int
+	}{
+		{"part of the cap is left", 95, 5},
+		{"cap is already reached", 100, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Лимит x1: на весь бонус со ставкой 100 — не больше 100
+			s := newTestServ(t, "line_max_win_x_bet: 1\n")
+			s.rng = rng.NewSeeded(3)
+			reached := false
+			for i := 0; i < 200; i++ {
+				fsState := newFreeSpinState(100, 3, tt.featureWin)
+				res, err := s.SpinOnce(context.Background(), model.LineSpin{Bet: 100, Lines: 3}, &fsState)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if res.TotalPayout > tt.wantCap {
+					t.Fatalf("spin %d paid %d over the remaining cap %d", i, res.TotalPayout, tt.wantCap)
+				}
+				if res.MaxWinReached && res.TotalPayout != tt.wantCap {
+					t.Fatalf("spin %d reached max win but paid %d, want %d", i, res.TotalPayout, tt.wantCap)
+				}
+				reached = reached || res.MaxWinReached
+			}
+			if !reached {
+				t.Fatal("no spin reached the cap in 200 free spins")
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package line
+
+import "casino_test/internal/model"
+
+// Предел шагов каскада за один спин
+const maxTumbleIter = 50
+
+// resolveTumbles прогоняет каскады: выигрышные символы уходят с поля, оставшиеся падают вниз,
+// сверху досыпаются новые, и поле оценивается заново, пока есть выигрыши.
+// Вайлд-барабаны и скаттеры остаются на месте. Множитель шага растёт на TumbleMultiplierStep.
+// Возвращает итоговое поле, шаги и сумму выигрышей по всем шагам.
+func (s *serv) resolveTumbles(board [5][3]string, wins []model.LineWin, spinReq model.LineSpin,
+	wildReels, wildMults []int, spinMult int) ([5][3]string, []model.LineTumbleStep, int) {
+	var steps []model.LineTumbleStep
+	var total int
+
+	for i := 0; i < maxTumbleIter && len(wins) > 0; i++ {
+		tumbleMult := 1 + i*s.cfg.TumbleMultiplierStep()
+		for j := range wins {
+			wins[j].Payout *= tumbleMult
+			wins[j].Multiplier *= tumbleMult
+			total += wins[j].Payout
+		}
+
+		step := model.LineTumbleStep{
+			Index:      i,
+			Board:      board,
+			Wins:       wins,
+			Multiplier: tumbleMult,
+		}
+
+		var removed [reels][rows]bool
+		for _, w := range wins {
+			for _, p := range w.Positions {
+				sym := board[p.Reel][p.Row]
+				if removed[p.Reel][p.Row] || sym == "W" || sym == "B" {
+					continue
+				}
+				removed[p.Reel][p.Row] = true
+				step.Removed = append(step.Removed, p)
+			}
+		}
+		// Удалять нечего (линия из одних вайлдов) — поле не изменится, дальше крутить бессмысленно
+		if len(step.Removed) == 0 {
+			steps = append(steps, step)
+			break
+		}
+
+		board, step.NewSymbols = s.collapseReels(board, removed)
+		steps = append(steps, step)
+
+		wins = s.evaluate(board, spinReq)
+		s.applyWinMultipliers(board, wins, wildReels, wildMults, spinMult)
+	}
+	return board, steps, total
+}
+
+// collapseReels сдвигает оставшиеся символы вниз (строка 0 — верхняя) и досыпает новые сверху
+func (s *serv) collapseReels(board [5][3]string, removed [reels][rows]bool) ([5][3]string, []model.LineNewSymbol) {
+	symbolWeights := s.cfg.SymbolWeights()
+	var newSymbols []model.LineNewSymbol
+
+	for r := 0; r < reels; r++ {
+		stack := make([]string, 0, rows)
+		hasScatter := false
+		for row := 0; row < rows; row++ {
+			if removed[r][row] {
+				continue
+			}
+			stack = append(stack, board[r][row])
+			if board[r][row] == "B" {
+				hasScatter = true
+			}
+		}
+
+		fill := rows - len(stack)
+		for row := 0; row < fill; row++ {
+			sym := s.randomReelSymbol(symbolWeights, &hasScatter)
+			board[r][row] = sym
+			newSymbols = append(newSymbols, model.LineNewSymbol{
+				LinePosition: model.LinePosition{Reel: r, Row: row},
+				Symbol:       sym,
+			})
+		}
+		for i, sym := range stack {
+			board[r][fill+i] = sym
+		}
+	}
+	return board, newSymbols
+}
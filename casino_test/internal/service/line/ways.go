@@ -0,0 +1,110 @@
+package line
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"sort"
+	"strings"
+)
+
+// EvaluateWays считает выигрыши в режиме «все пути» (243 ways для 5x3).
+// Символ платит, если он (или вайлд) есть на каждом барабане подряд начиная с первого
+// (с последнего — для выплат справа налево).
+// Выплата умножается на число путей — произведение количества подходящих ячеек на барабанах.
+func (s *serv) EvaluateWays(board [5][3]string, spinReq model.LineSpin) []model.LineWin {
+	var wins []model.LineWin
+	for _, dir := range s.payDirections() {
+		wins = append(wins, s.evaluateWaysDirection(board, spinReq, dir)...)
+	}
+	return wins
+}
+
+// waysCombo комбинация одного символа в режиме путей
+type waysCombo struct {
+	count    int
+	val      int
+	prefixes map[string]bool // Различные пути длины count (строки ячеек)
+	cells    map[model.LinePosition]bool
+}
+
+// evaluateWaysDirection считает пути в одном направлении.
+// Каждый путь оценивается как линия (matchLine) — с тем же «best pay»: одни вайлды по таблице
+// вайлдов или символ с подстановкой вайлдов. Символ платит только самую длинную комбинацию,
+// а число путей — количество различных путей этой длины.
+func (s *serv) evaluateWaysDirection(board [5][3]string, spinReq model.LineSpin, dir string) []model.LineWin {
+	order := reelOrder(dir)
+	combos := map[string]*waysCombo{}
+
+	rowsOnPath := make([]int, reels)
+	symbols := make([]string, reels)
+	var walk func(k int)
+	walk = func(k int) {
+		if k < reels {
+			for row := 0; row < rows; row++ {
+				rowsOnPath[k] = row
+				symbols[k] = board[order[k]][row]
+				walk(k + 1)
+			}
+			return
+		}
+
+		sym, count, val := s.matchLine(symbols)
+		if sym == "" {
+			return
+		}
+		combo := combos[sym]
+		if combo == nil || count > combo.count {
+			combo = &waysCombo{count: count, val: val, prefixes: map[string]bool{}, cells: map[model.LinePosition]bool{}}
+			combos[sym] = combo
+		}
+		if count < combo.count {
+			return
+		}
+		var prefix strings.Builder
+		for i := 0; i < count; i++ {
+			prefix.WriteByte(byte('0' + rowsOnPath[i]))
+			combo.cells[model.LinePosition{Reel: order[i], Row: rowsOnPath[i]}] = true
+		}
+		combo.prefixes[prefix.String()] = true
+	}
+	walk(0)
+
+	// Порядок выигрышей в ответе должен быть стабильным
+	syms := make([]string, 0, len(combos))
+	for sym := range combos {
+		syms = append(syms, sym)
+	}
+	sort.Strings(syms)
+
+	var wins []model.LineWin
+	for _, sym := range syms {
+		combo := combos[sym]
+		if dir == config.PayDirectionRightToLeft && combo.count == reels && s.skipFullLineRightToLeft() {
+			continue
+		}
+		ways := len(combo.prefixes)
+		wins = append(wins, model.LineWin{
+			Symbol:    sym,
+			Count:     combo.count,
+			Payout:    combo.val * ways * spinReq.Bet / (100 * s.cfg.WaysPayDivisor()),
+			Ways:      ways,
+			Positions: waysPositions(combo.cells, order),
+			Direction: dir,
+		})
+	}
+	return wins
+}
+
+// waysPositions ячейки комбинации по барабанам в порядке чтения, строки сверху вниз
+func waysPositions(cells map[model.LinePosition]bool, order []int) []model.LinePosition {
+	var positions []model.LinePosition
+	for _, r := range order {
+		for row := 0; row < rows; row++ {
+			pos := model.LinePosition{Reel: r, Row: row}
+			if cells[pos] {
+				positions = append(positions, pos)
+			}
+		}
+	}
+	return positions
+}
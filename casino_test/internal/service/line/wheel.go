@@ -0,0 +1,73 @@
+package line
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/service/wheel"
+	"context"
+	"errors"
+)
+
+// startWheel запускает колесо фортуны вместо фриспинов, если так настроены скаттеры
+func (s *serv) startWheel(res *model.SpinResult, bet int) (*model.WheelView, error) {
+	if s.cfg.ScatterFeature() != config.ScatterFeatureWheel || res.AwardedFreeSpins == 0 {
+		return nil, nil
+	}
+	res.AwardedFreeSpins = 0
+
+	state := wheel.Start(s.wheelCfg, bet)
+	if err := s.repo.UpdateWheelState(state); err != nil {
+		return nil, errors.New("failed to save wheel state")
+	}
+	view, err := wheel.View(s.wheelCfg, state)
+	if err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+// WheelSpin крутит колесо фортуны; выигрыш идёт на баланс, фриспины — на счёт фриспинов
+func (s *serv) WheelSpin(ctx context.Context) (*model.WheelResult, error) {
+	state, err := s.repo.GetWheelState()
+	if err != nil {
+		return nil, errors.New("failed to get wheel state")
+	}
+
+	spin, err := wheel.Spin(s.wheelCfg, s.rng, &state)
+	if err != nil {
+		return nil, err
+	}
+	spin.Payout = s.ApplyMaxPayout(spin.Payout, state.Bet, s.cfg.MaxWinXBet())
+
+	balance, err := s.wallet.Credit(spin.Payout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	freeSpins, err := s.repo.GetFreeSpinCount()
+	if err != nil {
+		return nil, errors.New("failed to get count free spins")
+	}
+	if spin.FreeSpins > 0 {
+		if freeSpins == 0 {
+			// Новый бонус — от ставки колеса на всех линиях, без липких вайлдов и с множителем x1
+			if err := s.repo.UpdateFreeSpinState(newFreeSpinState(state.Bet, len(s.cfg.Paylines()), spin.Payout)); err != nil {
+				return nil, errors.New("failed to reset free spin state")
+			}
+		}
+		freeSpins += spin.FreeSpins
+		if err := s.repo.UpdateFreeSpinCount(freeSpins); err != nil {
+			return nil, errors.New("failed to update count free spins")
+		}
+	}
+
+	if err := s.repo.UpdateWheelState(state); err != nil {
+		return nil, errors.New("failed to save wheel state")
+	}
+
+	return &model.WheelResult{
+		Spin:          spin,
+		Balance:       balance,
+		FreeSpinCount: freeSpins,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package mines
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/money"
+	"context"
+	"errors"
+)
+
+// Cashout забирает выигрыш по текущему множителю
+func (s *serv) Cashout(ctx context.Context, playerID string) (*model.MinesState, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.activeRound(playerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(round.Revealed) == 0 {
+		return nil, errors.New("reveal at least one tile before cashing out")
+	}
+	return s.cashout(round)
+}
+
+// cashout зачисляет выигрыш и закрывает раунд; вызывается под s.mtx
+func (s *serv) cashout(round model.MinesRound) (*model.MinesState, error) {
+	round.Payout = money.Payout(round.Bet, round.Multiplier)
+	round.Status = model.MinesStatusCashedOut
+	round.NextMultiplier = 0
+
+	if _, err := s.wallet.Credit(round.Payout); err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+	if err := s.repo.UpdateRound(round); err != nil {
+		return nil, errors.New("failed to save mines round")
+	}
+	return s.state(&round)
+}
@@ -0,0 +1,50 @@
+package mines
+
+import (
+	"casino_test/internal/config"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// newSalt случайная соль, которой закрывается расклад мин
+func newSalt() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// layoutHash хэш расклада: sha256("соль:клетки по возрастанию через запятую").
+// Публикуется при старте, поэтому сервер не может переставить мины по ходу раунда.
+func layoutHash(salt string, layout []int) string {
+	sorted := slices.Sorted(slices.Values(layout))
+	cells := make([]string, len(sorted))
+	for i, c := range sorted {
+		cells[i] = strconv.Itoa(c)
+	}
+	sum := sha256.Sum256([]byte(salt + ":" + strings.Join(cells, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// multiplier множитель после revealed безопасных клеток при mines минах.
+// Честный множитель — обратная вероятность не задеть мину: C(25, k) / C(25 - mines, k),
+// из него вычитается преимущество казино, результат округляется вниз до сотых.
+// Возвращает 0, если открыть столько клеток нельзя.
+func multiplier(mines, revealed int, edge float64) float64 {
+	safe := config.MinesTiles - mines
+	if revealed > safe {
+		return 0
+	}
+	fair := 1.0
+	for i := 0; i < revealed; i++ {
+		fair *= float64(config.MinesTiles-i) / float64(safe-i)
+	}
+	// Погрешность произведения дробей (24.99999…) не должна съедать сотую при округлении вниз
+	return math.Floor(fair*(1-edge)*100+1e-9) / 100
+}
@@ -0,0 +1,42 @@
+package mines
+
+import "testing"
+
+func TestMultiplier(t *testing.T) {
+	tests := []struct {
+		name     string
+		mines    int
+		revealed int
+		edge     float64
+		want     float64
+	}{
+		{"nothing revealed", 3, 0, 0, 1},
+		{"one mine, one tile", 1, 1, 0, 1.04},
+		{"one mine, one tile with edge", 1, 1, 0.01, 1.03},
+		{"three mines, two tiles", 3, 2, 0, 1.29},
+		{"24 mines, the only safe tile", 24, 1, 0.01, 24.75},
+		{"whole field cleared", 1, 24, 0, 25},
+		{"more tiles than are safe", 24, 2, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := multiplier(tt.mines, tt.revealed, tt.edge); got != tt.want {
+				t.Errorf("multiplier(%d, %d, %v) = %v, want %v", tt.mines, tt.revealed, tt.edge, got, tt.want)
+			}
+		})
+	}
+}
+
+// Хэш расклада не зависит от порядка клеток, но меняется вместе с солью и раскладом
+func TestLayoutHash(t *testing.T) {
+	base := layoutHash("salt", []int{3, 7, 12})
+	if got := layoutHash("salt", []int{12, 3, 7}); got != base {
+		t.Errorf("hash depends on cell order: %s != %s", got, base)
+	}
+	if layoutHash("other", []int{3, 7, 12}) == base {
+		t.Error("hash does not depend on salt")
+	}
+	if layoutHash("salt", []int{3, 7, 13}) == base {
+		t.Error("hash does not depend on layout")
+	}
+}
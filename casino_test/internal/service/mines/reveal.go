@@ -0,0 +1,69 @@
+package mines
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Reveal открывает клетку. Мина заканчивает раунд проигрышем;
+// если открыты все безопасные клетки, выигрыш забирается автоматически.
+func (s *serv) Reveal(ctx context.Context, playerID string, tile int) (*model.MinesState, error) {
+	if tile < 0 || tile >= config.MinesTiles {
+		return nil, fmt.Errorf("tile must be between 0 and %d", config.MinesTiles-1)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, err := s.activeRound(playerID)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(round.Revealed, tile) {
+		return nil, errors.New("tile is already revealed")
+	}
+
+	if slices.Contains(round.Layout, tile) {
+		round.Status = model.MinesStatusBusted
+		round.HitMine = tile
+		round.Multiplier = 0
+		round.NextMultiplier = 0
+		if err := s.repo.UpdateRound(round); err != nil {
+			return nil, errors.New("failed to save mines round")
+		}
+		return s.state(&round)
+	}
+
+	round.Revealed = append(round.Revealed, tile)
+	round.Multiplier = multiplier(round.Mines, len(round.Revealed), s.cfg.HouseEdge())
+	round.NextMultiplier = multiplier(round.Mines, len(round.Revealed)+1, s.cfg.HouseEdge())
+
+	// Открывать больше нечего — забираем выигрыш за игрока
+	if len(round.Revealed) == config.MinesTiles-round.Mines {
+		return s.cashout(round)
+	}
+
+	if err := s.repo.UpdateRound(round); err != nil {
+		return nil, errors.New("failed to save mines round")
+	}
+	return s.state(&round)
+}
+
+// activeRound возвращает незавершённый раунд игрока
+func (s *serv) activeRound(playerID string) (model.MinesRound, error) {
+	if playerID == "" {
+		return model.MinesRound{}, errors.New("player id is required")
+	}
+	round, ok, err := s.repo.GetRound(playerID)
+	if err != nil {
+		return model.MinesRound{}, errors.New("failed to get mines round")
+	}
+	if !ok || round.Status != model.MinesStatusActive {
+		return model.MinesRound{}, errors.New("no mines round in progress")
+	}
+	return round, nil
+}
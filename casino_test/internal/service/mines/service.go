@@ -0,0 +1,29 @@
+package mines
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"sync"
+)
+
+type serv struct {
+	cfg    config.MinesConfig
+	repo   repository.MinesRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+
+	// Действия игроков выполняются по одному — открыть клетку и забрать выигрыш одновременно нельзя
+	mtx sync.Mutex
+}
+
+// NewMinesService Создать игру Mines 5x5
+func NewMinesService(cfg config.MinesConfig, repo repository.MinesRepository, wallet repository.WalletRepository, r rng.RNG) service.MinesService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
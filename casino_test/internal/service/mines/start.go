@@ -0,0 +1,68 @@
+package mines
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Start списывает ставку и начинает раунд: расклад мин выбирается сразу и закрывается хэшем
+func (s *serv) Start(ctx context.Context, start model.MinesStart) (*model.MinesState, error) {
+	if start.PlayerID == "" {
+		return nil, errors.New("player id is required")
+	}
+	if start.Bet < s.cfg.MinBet() || start.Bet > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+	if start.Mines < s.cfg.MinMines() || start.Mines > s.cfg.MaxMines() {
+		return nil, fmt.Errorf("mines must be between %d and %d", s.cfg.MinMines(), s.cfg.MaxMines())
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	prev, ok, err := s.repo.GetRound(start.PlayerID)
+	if err != nil {
+		return nil, errors.New("failed to get mines round")
+	}
+	if ok && prev.Status == model.MinesStatusActive {
+		return nil, errors.New("round is already in progress: reveal a tile or cash out")
+	}
+
+	salt, err := newSalt()
+	if err != nil {
+		return nil, errors.New("failed to generate mines layout")
+	}
+	tiles := make([]int, config.MinesTiles)
+	for i := range tiles {
+		tiles[i] = i
+	}
+	s.rng.Shuffle(len(tiles), func(i, j int) {
+		tiles[i], tiles[j] = tiles[j], tiles[i]
+	})
+	layout := tiles[:start.Mines]
+
+	if _, err := s.wallet.Debit(start.Bet); err != nil {
+		return nil, err
+	}
+
+	round := model.MinesRound{
+		PlayerID:       start.PlayerID,
+		Status:         model.MinesStatusActive,
+		Bet:            start.Bet,
+		Mines:          start.Mines,
+		Layout:         layout,
+		Revealed:       []int{},
+		Hash:           layoutHash(salt, layout),
+		Salt:           salt,
+		Multiplier:     multiplier(start.Mines, 0, s.cfg.HouseEdge()),
+		NextMultiplier: multiplier(start.Mines, 1, s.cfg.HouseEdge()),
+		HitMine:        -1,
+	}
+	if err := s.repo.UpdateRound(round); err != nil {
+		return nil, errors.New("failed to save mines round")
+	}
+	return s.state(&round)
+}
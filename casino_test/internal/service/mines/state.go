@@ -0,0 +1,35 @@
+package mines
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// State возвращает последний раунд игрока, чтобы интерфейс мог продолжить игру после переподключения
+func (s *serv) State(ctx context.Context, playerID string) (*model.MinesState, error) {
+	if playerID == "" {
+		return nil, errors.New("player id is required")
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	round, ok, err := s.repo.GetRound(playerID)
+	if err != nil {
+		return nil, errors.New("failed to get mines round")
+	}
+	if !ok {
+		return s.state(nil)
+	}
+	return s.state(&round)
+}
+
+// state добавляет к раунду текущий баланс
+func (s *serv) state(round *model.MinesRound) (*model.MinesState, error) {
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, errors.New("failed to get user balance")
+	}
+	return &model.MinesState{Round: round, Balance: balance}, nil
+}
@@ -0,0 +1,11 @@
+// Package money денежные расчёты, общие для игр
+package money
+
+import "math"
+
+// Payout выигрыш ставки bet с множителем mult.
+// Множитель переводим в сотые и считаем в целых, чтобы погрешность float
+// (1.15 → 1.149999) не съедала копейки
+func Payout(bet int, mult float64) int {
+	return bet * int(math.Round(mult*100)) / 100
+}
@@ -0,0 +1,28 @@
+package money
+
+import "testing"
+
+func TestPayout(t *testing.T) {
+	tests := []struct {
+		name string
+		bet  int
+		mult float64
+		want int
+	}{
+		{"zero multiplier", 100, 0, 0},
+		{"even money", 100, 2, 200},
+		{"float error does not lose a cent", 100, 1.15, 115},
+		{"float error on larger bet", 1000, 1.13, 1130},
+		{"rounds multiplier to hundredths", 100, 1.999, 200},
+		{"result rounds down", 3, 1.5, 4},
+		{"below one", 10, 0.5, 5},
+		{"big multiplier", 7, 1000, 7000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Payout(tt.bet, tt.mult); got != tt.want {
+				t.Errorf("Payout(%d, %v) = %d, want %d", tt.bet, tt.mult, got, tt.want)
+			}
+		})
+	}
+}
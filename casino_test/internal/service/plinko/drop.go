@@ -0,0 +1,61 @@
+package plinko
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/internal/service/money"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Drop списывает ставку, бросает шарик через rows рядов колышков и зачисляет выигрыш лунки.
+// На каждом колышке шарик с равной вероятностью уходит влево или вправо,
+// лунка — число отскоков вправо.
+func (s *serv) Drop(ctx context.Context, drop model.PlinkoDrop) (*model.PlinkoDropResult, error) {
+	if drop.Bet < s.cfg.MinBet() || drop.Bet > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+	if !s.betCfg.IsAllowedBet(drop.Bet) {
+		return nil, errors.New("bet is not on the bet ladder")
+	}
+	if drop.Rows < config.PlinkoMinRows || drop.Rows > config.PlinkoMaxRows {
+		return nil, fmt.Errorf("rows must be between %d and %d", config.PlinkoMinRows, config.PlinkoMaxRows)
+	}
+	mults, ok := s.cfg.Multipliers(drop.Risk, drop.Rows)
+	if !ok {
+		return nil, fmt.Errorf("unknown risk level %q", drop.Risk)
+	}
+
+	if _, err := s.wallet.Debit(drop.Bet); err != nil {
+		return nil, err
+	}
+
+	path := make([]string, drop.Rows)
+	bucket := 0
+	for i := range path {
+		if s.rng.Intn(2) == 0 {
+			path[i] = model.PlinkoLeft
+			continue
+		}
+		path[i] = model.PlinkoRight
+		bucket++
+	}
+
+	mult := mults[bucket]
+	payout := money.Payout(drop.Bet, mult)
+
+	balance, err := s.wallet.Credit(payout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	return &model.PlinkoDropResult{
+		PlinkoDrop: drop,
+		Path:       path,
+		Bucket:     bucket,
+		Multiplier: mult,
+		Payout:     payout,
+		Balance:    balance,
+	}, nil
+}
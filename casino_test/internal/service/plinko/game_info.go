@@ -0,0 +1,29 @@
+package plinko
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+)
+
+// GameInfo возвращает таблицы множителей для всех рисков и рядов, лимиты и лестницу ставок
+func (s *serv) GameInfo() (*model.PlinkoGameInfo, error) {
+	var tables []model.PlinkoTable
+	for _, risk := range []string{config.PlinkoRiskLow, config.PlinkoRiskMedium, config.PlinkoRiskHigh} {
+		for rows := config.PlinkoMinRows; rows <= config.PlinkoMaxRows; rows++ {
+			mults, ok := s.cfg.Multipliers(risk, rows)
+			if !ok {
+				continue
+			}
+			tables = append(tables, model.PlinkoTable{Risk: risk, Rows: rows, Multipliers: mults})
+		}
+	}
+
+	return &model.PlinkoGameInfo{
+		MinRows:   config.PlinkoMinRows,
+		MaxRows:   config.PlinkoMaxRows,
+		MinBet:    s.cfg.MinBet(),
+		MaxBet:    s.cfg.MaxBet(),
+		BetLadder: s.betCfg.BetLadder(),
+		Tables:    tables,
+	}, nil
+}
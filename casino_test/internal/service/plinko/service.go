@@ -0,0 +1,25 @@
+package plinko
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+)
+
+type serv struct {
+	cfg    config.PlinkoConfig
+	betCfg config.BetConfig
+	wallet repository.WalletRepository
+	rng    rng.RNG
+}
+
+// NewPlinkoService Создать плинко
+func NewPlinkoService(cfg config.PlinkoConfig, betCfg config.BetConfig, wallet repository.WalletRepository, r rng.RNG) service.PlinkoService {
+	return &serv{
+		cfg:    cfg,
+		betCfg: betCfg,
+		wallet: wallet,
+		rng:    r,
+	}
+}
@@ -0,0 +1,168 @@
+package roulette
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"fmt"
+	"slices"
+)
+
+// Числа на колесе: 0 и 1-36
+const maxNumber = 36
+
+var redNumbers = map[int]bool{
+	1: true, 3: true, 5: true, 7: true, 9: true, 12: true, 14: true, 16: true, 18: true,
+	19: true, 21: true, 23: true, 25: true, 27: true, 30: true, 32: true, 34: true, 36: true,
+}
+
+// Выплата к ставке (без учёта возвращаемой ставки)
+var payouts = map[string]int{
+	config.RouletteBetStraight: 35,
+	config.RouletteBetSplit:    17,
+	config.RouletteBetStreet:   11,
+	config.RouletteBetCorner:   8,
+	config.RouletteBetSixLine:  5,
+	config.RouletteBetDozen:    2,
+	config.RouletteBetColumn:   2,
+	config.RouletteBetRed:      1,
+	config.RouletteBetBlack:    1,
+	config.RouletteBetOdd:      1,
+	config.RouletteBetEven:     1,
+	config.RouletteBetLow:      1,
+	config.RouletteBetHigh:     1,
+}
+
+// isInside внутренняя ставка (на конкретные числа)
+func isInside(betType string) bool {
+	switch betType {
+	case config.RouletteBetStraight, config.RouletteBetSplit, config.RouletteBetStreet,
+		config.RouletteBetCorner, config.RouletteBetSixLine:
+		return true
+	}
+	return false
+}
+
+// covered проверяет форму ставки на столе и возвращает числа, которые она покрывает.
+// Стол: 12 рядов по 3 числа, ряд r — числа 3r+1..3r+3, над первым рядом зеро.
+func covered(bet model.RouletteBet) ([]int, error) {
+	nums := slices.Clone(bet.Numbers)
+	slices.Sort(nums)
+	for i, n := range nums {
+		if n < 0 || n > maxNumber {
+			return nil, fmt.Errorf("%s: number %d is not on the table", bet.Type, n)
+		}
+		if i > 0 && nums[i-1] == n {
+			return nil, fmt.Errorf("%s: number %d is repeated", bet.Type, n)
+		}
+	}
+
+	var ok bool
+	switch bet.Type {
+	case config.RouletteBetStraight:
+		ok = len(nums) == 1
+	case config.RouletteBetSplit:
+		ok = isSplit(nums)
+	case config.RouletteBetStreet:
+		ok = isStreet(nums)
+	case config.RouletteBetCorner:
+		ok = isCorner(nums)
+	case config.RouletteBetSixLine:
+		ok = len(nums) == 6 && nums[0] > 0 && (nums[0]-1)%3 == 0 && nums[5] == nums[0]+5
+	case config.RouletteBetDozen, config.RouletteBetColumn:
+		if len(nums) != 1 || nums[0] < 1 || nums[0] > 3 {
+			return nil, fmt.Errorf("%s: choose one of 1, 2, 3", bet.Type)
+		}
+		return outsideNumbers(bet.Type, nums[0]), nil
+	case config.RouletteBetRed, config.RouletteBetBlack, config.RouletteBetOdd,
+		config.RouletteBetEven, config.RouletteBetLow, config.RouletteBetHigh:
+		if len(nums) != 0 {
+			return nil, fmt.Errorf("%s: numbers are not allowed", bet.Type)
+		}
+		return outsideNumbers(bet.Type, 0), nil
+	default:
+		return nil, fmt.Errorf("unknown bet type %q", bet.Type)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%s: numbers %v do not form a valid bet", bet.Type, bet.Numbers)
+	}
+	return nums, nil
+}
+
+// isSplit два соседних числа: по горизонтали, по вертикали или зеро с 1, 2, 3
+func isSplit(nums []int) bool {
+	if len(nums) != 2 {
+		return false
+	}
+	a, b := nums[0], nums[1]
+	if a == 0 {
+		return b >= 1 && b <= 3
+	}
+	sameRow := (a-1)/3 == (b-1)/3
+	return (b-a == 1 && sameRow) || b-a == 3
+}
+
+// isStreet ряд из трёх чисел или «трио» с зеро (0-1-2, 0-2-3)
+func isStreet(nums []int) bool {
+	if len(nums) != 3 {
+		return false
+	}
+	if nums[0] == 0 {
+		return nums[1] == 1 && nums[2] == 2 || nums[1] == 2 && nums[2] == 3
+	}
+	return (nums[0]-1)%3 == 0 && nums[2] == nums[0]+2
+}
+
+// isCorner квадрат из четырёх чисел или первая четвёрка 0-1-2-3
+func isCorner(nums []int) bool {
+	if len(nums) != 4 {
+		return false
+	}
+	if nums[0] == 0 {
+		return nums[1] == 1 && nums[2] == 2 && nums[3] == 3
+	}
+	n := nums[0]
+	return (n-1)%3 != 2 && nums[1] == n+1 && nums[2] == n+3 && nums[3] == n+4
+}
+
+// outsideNumbers числа внешней ставки; part — номер дюжины или колонки
+func outsideNumbers(betType string, part int) []int {
+	var result []int
+	for n := 1; n <= maxNumber; n++ {
+		var hit bool
+		switch betType {
+		case config.RouletteBetDozen:
+			hit = (n-1)/12 == part-1
+		case config.RouletteBetColumn:
+			hit = (n-1)%3 == part-1
+		case config.RouletteBetRed:
+			hit = redNumbers[n]
+		case config.RouletteBetBlack:
+			hit = !redNumbers[n]
+		case config.RouletteBetOdd:
+			hit = n%2 == 1
+		case config.RouletteBetEven:
+			hit = n%2 == 0
+		case config.RouletteBetLow:
+			hit = n <= 18
+		case config.RouletteBetHigh:
+			hit = n > 18
+		}
+		if hit {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// numberColor цвет числа на колесе
+func numberColor(n int) string {
+	switch {
+	case n == 0:
+		return "green"
+	case redNumbers[n]:
+		return "red"
+	default:
+		return "black"
+	}
+}
@@ -0,0 +1,89 @@
+package roulette
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"slices"
+	"testing"
+)
+
+func TestCovered(t *testing.T) {
+	tests := []struct {
+		name    string
+		bet     model.RouletteBet
+		want    []int
+		wantErr bool
+	}{
+		{"straight", model.RouletteBet{Type: config.RouletteBetStraight, Numbers: []int{17}}, []int{17}, false},
+		{"straight on zero", model.RouletteBet{Type: config.RouletteBetStraight, Numbers: []int{0}}, []int{0}, false},
+		{"straight off the table", model.RouletteBet{Type: config.RouletteBetStraight, Numbers: []int{37}}, nil, true},
+		{"horizontal split", model.RouletteBet{Type: config.RouletteBetSplit, Numbers: []int{2, 1}}, []int{1, 2}, false},
+		{"vertical split", model.RouletteBet{Type: config.RouletteBetSplit, Numbers: []int{14, 17}}, []int{14, 17}, false},
+		{"split with zero", model.RouletteBet{Type: config.RouletteBetSplit, Numbers: []int{0, 3}}, []int{0, 3}, false},
+		{"split across rows", model.RouletteBet{Type: config.RouletteBetSplit, Numbers: []int{3, 4}}, nil, true},
+		{"split repeats a number", model.RouletteBet{Type: config.RouletteBetSplit, Numbers: []int{5, 5}}, nil, true},
+		{"street", model.RouletteBet{Type: config.RouletteBetStreet, Numbers: []int{34, 35, 36}}, []int{34, 35, 36}, false},
+		{"trio with zero", model.RouletteBet{Type: config.RouletteBetStreet, Numbers: []int{0, 2, 3}}, []int{0, 2, 3}, false},
+		{"street across rows", model.RouletteBet{Type: config.RouletteBetStreet, Numbers: []int{2, 3, 4}}, nil, true},
+		{"corner", model.RouletteBet{Type: config.RouletteBetCorner, Numbers: []int{1, 2, 4, 5}}, []int{1, 2, 4, 5}, false},
+		{"first four", model.RouletteBet{Type: config.RouletteBetCorner, Numbers: []int{0, 1, 2, 3}}, []int{0, 1, 2, 3}, false},
+		{"corner across the right edge", model.RouletteBet{Type: config.RouletteBetCorner, Numbers: []int{3, 4, 6, 7}}, nil, true},
+		{"six line", model.RouletteBet{Type: config.RouletteBetSixLine, Numbers: []int{31, 32, 33, 34, 35, 36}}, []int{31, 32, 33, 34, 35, 36}, false},
+		{"six line off a row start", model.RouletteBet{Type: config.RouletteBetSixLine, Numbers: []int{2, 3, 4, 5, 6, 7}}, nil, true},
+		{"second dozen", model.RouletteBet{Type: config.RouletteBetDozen, Numbers: []int{2}}, []int{13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24}, false},
+		{"first column", model.RouletteBet{Type: config.RouletteBetColumn, Numbers: []int{1}}, []int{1, 4, 7, 10, 13, 16, 19, 22, 25, 28, 31, 34}, false},
+		{"dozen out of range", model.RouletteBet{Type: config.RouletteBetDozen, Numbers: []int{4}}, nil, true},
+		{"even money with numbers", model.RouletteBet{Type: config.RouletteBetRed, Numbers: []int{1}}, nil, true},
+		{"unknown type", model.RouletteBet{Type: "basket", Numbers: []int{1}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := covered(tt.bet)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("covered(%+v) error = %v, wantErr %v", tt.bet, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("covered(%+v) = %v, want %v", tt.bet, got, tt.want)
+			}
+		})
+	}
+}
+
+// Равные шансы покрывают по 18 чисел и никогда не выигрывают на зеро
+func TestCoveredEvenMoney(t *testing.T) {
+	for _, betType := range []string{
+		config.RouletteBetRed, config.RouletteBetBlack, config.RouletteBetOdd,
+		config.RouletteBetEven, config.RouletteBetLow, config.RouletteBetHigh,
+	} {
+		t.Run(betType, func(t *testing.T) {
+			got, err := covered(model.RouletteBet{Type: betType})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 18 || slices.Contains(got, 0) {
+				t.Errorf("%s covers %v, want 18 numbers without zero", betType, got)
+			}
+		})
+	}
+}
+
+func TestNumberColor(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{0, "green"},
+		{1, "red"},
+		{2, "black"},
+		{10, "black"},
+		{11, "black"},
+		{19, "red"},
+		{28, "black"},
+		{36, "red"},
+	}
+	for _, tt := range tests {
+		if got := numberColor(tt.n); got != tt.want {
+			t.Errorf("numberColor(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
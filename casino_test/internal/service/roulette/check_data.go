@@ -0,0 +1,18 @@
+package roulette
+
+import "casino_test/internal/model"
+
+func (s *serv) CheckData() (*model.RouletteData, error) {
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, err
+	}
+	history, err := s.repo.GetHistory()
+	if err != nil {
+		return nil, err
+	}
+	return &model.RouletteData{
+		Balance: balance,
+		History: history,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package roulette
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+)
+
+type serv struct {
+	cfg    config.RouletteConfig
+	repo   repository.RouletteRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+}
+
+// NewRouletteService Создать стол европейской рулетки (одно зеро)
+func NewRouletteService(cfg config.RouletteConfig, repo repository.RouletteRepository, wallet repository.WalletRepository, r rng.RNG) service.RouletteService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
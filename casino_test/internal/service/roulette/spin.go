@@ -0,0 +1,83 @@
+package roulette
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Spin принимает ставки, проверяет их по правилам и лимитам стола, крутит колесо и рассчитывает выплаты
+func (s *serv) Spin(ctx context.Context, bets []model.RouletteBet) (*model.RouletteSpinResult, error) {
+	if len(bets) == 0 {
+		return nil, errors.New("no bets placed")
+	}
+	if len(bets) > s.cfg.MaxBetsPerSpin() {
+		return nil, fmt.Errorf("too many bets: at most %d per spin", s.cfg.MaxBetsPerSpin())
+	}
+
+	results := make([]model.RouletteBetResult, len(bets))
+	totalBet := 0
+	for i, bet := range bets {
+		nums, err := covered(bet)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkLimits(bet); err != nil {
+			return nil, err
+		}
+		totalBet += bet.Amount
+		results[i] = model.RouletteBetResult{RouletteBet: bet, Covered: nums}
+	}
+	if totalBet > s.cfg.MaxTotalBet() {
+		return nil, fmt.Errorf("total bet exceeds table limit %d", s.cfg.MaxTotalBet())
+	}
+
+	if _, err := s.wallet.Debit(totalBet); err != nil {
+		return nil, err
+	}
+
+	number := s.rng.Intn(maxNumber + 1)
+	totalPayout := 0
+	for i := range results {
+		res := &results[i]
+		if slices.Contains(res.Covered, number) {
+			res.Won = true
+			res.Payout = res.Amount * (payouts[res.Type] + 1)
+			totalPayout += res.Payout
+		}
+	}
+
+	balance, err := s.wallet.Credit(totalPayout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+	if err := s.repo.AddResult(number); err != nil {
+		return nil, errors.New("failed to save roulette history")
+	}
+
+	return &model.RouletteSpinResult{
+		Number:      number,
+		Color:       numberColor(number),
+		Bets:        results,
+		TotalBet:    totalBet,
+		TotalPayout: totalPayout,
+		Balance:     balance,
+	}, nil
+}
+
+// checkLimits проверяет ставку по лимитам стола
+func (s *serv) checkLimits(bet model.RouletteBet) error {
+	if bet.Amount < s.cfg.MinBet() {
+		return fmt.Errorf("%s: bet is below table minimum %d", bet.Type, s.cfg.MinBet())
+	}
+	maxBet := s.cfg.MaxOutsideBet()
+	if isInside(bet.Type) {
+		maxBet = s.cfg.MaxInsideBet()
+	}
+	if bet.Amount > maxBet {
+		return fmt.Errorf("%s: bet exceeds table maximum %d", bet.Type, maxBet)
+	}
+	return nil
+}
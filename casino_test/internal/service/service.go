@@ -7,14 +7,87 @@ import (
 
 type LineService interface {
 	Spin(ctx context.Context, spinReq model.LineSpin) (*model.SpinResult, error)
+	HoldAndWinRespin(ctx context.Context) (*model.HoldAndWinResult, error)
+	PickEmPick(ctx context.Context, index int) (*model.PickEmResult, error)
+	WheelSpin(ctx context.Context) (*model.WheelResult, error)
+	Gamble(ctx context.Context, choice string) (*model.GambleResult, error)
+	Collect(ctx context.Context) (*model.GambleCollect, error)
 	BuyBonus(amount int) error
-	Deposit(amount int) error
 	CheckData() (*model.Data, error)
 }
 
 type CascadeService interface {
 	Spin(ctx context.Context, req model.CascadeSpin) (*model.CascadeSpinResult, error)
-	BuyBonus(amount int) error
-	Deposit(amount int) error
+	WheelSpin(ctx context.Context) (*model.WheelResult, error)
+	BuyBonus(req model.CascadeBuyBonus) (*model.CascadeBuyBonusResult, error)
 	CheckData() (*model.CascadeData, error)
+	GameInfo() (*model.CascadeGameInfo, error)
+}
+
+// WalletService пополнение и баланс общего кошелька
+type WalletService interface {
+	Deposit(ctx context.Context, amount int) (*model.WalletData, error)
+	CheckData(ctx context.Context) (*model.WalletData, error)
+}
+
+type JackpotService interface {
+	Contribute(ctx context.Context, gameID string, bet, scatters int) ([]model.JackpotWin, error)
+	Pools(ctx context.Context) ([]model.JackpotPool, error)
+}
+
+type RouletteService interface {
+	Spin(ctx context.Context, bets []model.RouletteBet) (*model.RouletteSpinResult, error)
+	CheckData() (*model.RouletteData, error)
+}
+
+type CrashService interface {
+	// Run крутит раунды, пока не отменён ctx
+	Run(ctx context.Context)
+	PlaceBet(ctx context.Context, bet model.CrashBet) (*model.CrashBet, error)
+	Cashout(ctx context.Context, token string) (*model.CrashBet, error)
+	State(ctx context.Context) (*model.CrashState, error)
+	// Subscribe подписывает на ленту событий; отписка — вызов возвращённой функции
+	Subscribe() (<-chan model.CrashEvent, func())
+}
+
+type PlinkoService interface {
+	Drop(ctx context.Context, drop model.PlinkoDrop) (*model.PlinkoDropResult, error)
+	GameInfo() (*model.PlinkoGameInfo, error)
+}
+
+type MinesService interface {
+	Start(ctx context.Context, start model.MinesStart) (*model.MinesState, error)
+	Reveal(ctx context.Context, playerID string, tile int) (*model.MinesState, error)
+	Cashout(ctx context.Context, playerID string) (*model.MinesState, error)
+	State(ctx context.Context, playerID string) (*model.MinesState, error)
+}
+
+type VideoPokerService interface {
+	Deal(ctx context.Context, bet int) (*model.VideoPokerDeal, error)
+	Draw(ctx context.Context, held []bool) (*model.VideoPokerDraw, error)
+	CheckData() (*model.VideoPokerData, error)
+}
+
+type BlackjackService interface {
+	Deal(ctx context.Context, bet int) (*model.BlackjackTable, error)
+	Hit(ctx context.Context) (*model.BlackjackTable, error)
+	Stand(ctx context.Context) (*model.BlackjackTable, error)
+	Double(ctx context.Context) (*model.BlackjackTable, error)
+	Split(ctx context.Context) (*model.BlackjackTable, error)
+	Insurance(ctx context.Context, take bool) (*model.BlackjackTable, error)
+	Surrender(ctx context.Context) (*model.BlackjackTable, error)
+	State(ctx context.Context) (*model.BlackjackTable, error)
+}
+
+type KenoService interface {
+	// BuyTicket списывает ставку за все тиражи билета, разыгрывает их и зачисляет выигрыш
+	BuyTicket(ctx context.Context, purchase model.KenoPurchase) (*model.KenoTicketResult, error)
+	Tickets(ctx context.Context) ([]model.KenoTicket, error)
+	Ticket(ctx context.Context, id int) (*model.KenoTicket, error)
+	GameInfo() (*model.KenoGameInfo, error)
+}
+
+// GameCatalog список подключённых игр
+type GameCatalog interface {
+	List() []model.GameMeta
 }
@@ -0,0 +1,32 @@
+package videopoker
+
+import (
+	"casino_test/internal/model"
+	"errors"
+)
+
+// CheckData возвращает баланс, незавершённую раздачу (чтобы продолжить её после перезагрузки) и таблицу выплат
+func (s *serv) CheckData() (*model.VideoPokerData, error) {
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, errors.New("failed to get user balance")
+	}
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get video poker state")
+	}
+
+	data := &model.VideoPokerData{
+		Balance:  balance,
+		PayTable: s.cfg.PayTable(),
+	}
+	if state.Active {
+		data.Hand = &model.VideoPokerDeal{
+			Bet:     state.Bet,
+			Hand:    state.Hand,
+			Combo:   evaluate(state.Hand),
+			Balance: balance,
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,50 @@
+package videopoker
+
+import (
+	"casino_test/internal/model"
+	"casino_test/internal/service/cards"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Deal списывает ставку и сдаёт пять карт из новой перемешанной колоды
+func (s *serv) Deal(ctx context.Context, bet int) (*model.VideoPokerDeal, error) {
+	if bet < s.cfg.MinBet() || bet > s.cfg.MaxBet() {
+		return nil, fmt.Errorf("bet must be between %d and %d", s.cfg.MinBet(), s.cfg.MaxBet())
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get video poker state")
+	}
+	if state.Active {
+		return nil, errors.New("hand is already dealt: draw first")
+	}
+
+	balance, err := s.wallet.Debit(bet)
+	if err != nil {
+		return nil, err
+	}
+
+	deck := cards.Shuffled(s.rng, 1)
+	state = model.VideoPokerState{
+		Active: true,
+		Bet:    bet,
+		Hand:   deck[:model.VideoPokerHandSize],
+		Deck:   deck[model.VideoPokerHandSize:],
+	}
+	if err := s.repo.UpdateState(state); err != nil {
+		return nil, errors.New("failed to save video poker state")
+	}
+
+	return &model.VideoPokerDeal{
+		Bet:     bet,
+		Hand:    state.Hand,
+		Combo:   evaluate(state.Hand),
+		Balance: balance,
+	}, nil
+}
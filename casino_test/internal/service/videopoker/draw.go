@@ -0,0 +1,58 @@
+package videopoker
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Draw меняет неудержанные карты на следующие из той же колоды и выплачивает итоговую комбинацию
+func (s *serv) Draw(ctx context.Context, held []bool) (*model.VideoPokerDraw, error) {
+	if len(held) != model.VideoPokerHandSize {
+		return nil, fmt.Errorf("held must have %d flags", model.VideoPokerHandSize)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	state, err := s.repo.GetState()
+	if err != nil {
+		return nil, errors.New("failed to get video poker state")
+	}
+	if !state.Active {
+		return nil, errors.New("no hand dealt: deal first")
+	}
+
+	hand := slices.Clone(state.Hand)
+	next := 0
+	for i, keep := range held {
+		if keep {
+			continue
+		}
+		hand[i] = state.Deck[next]
+		next++
+	}
+
+	combo := evaluate(hand)
+	payout := state.Bet * s.cfg.PayTable()[combo]
+
+	// Рука разыграна — закрываем её до зачисления, чтобы повторный draw не прошёл
+	if err := s.repo.UpdateState(model.VideoPokerState{}); err != nil {
+		return nil, errors.New("failed to save video poker state")
+	}
+	balance, err := s.wallet.Credit(payout)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+
+	return &model.VideoPokerDraw{
+		Bet:     state.Bet,
+		Hand:    hand,
+		Held:    held,
+		Combo:   combo,
+		Payout:  payout,
+		Balance: balance,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package videopoker
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"slices"
+)
+
+// evaluate определяет старшую комбинацию Jacks or Better; "" — рука ничего не платит
+func evaluate(hand []model.Card) string {
+	counts := map[int]int{}
+	flush := true
+	for i, card := range hand {
+		counts[card.Rank]++
+		if i > 0 && card.Suit != hand[0].Suit {
+			flush = false
+		}
+	}
+
+	// Сколько раз встречается каждый ранг, по убыванию: [4 1], [3 2], [2 2 1] ...
+	groups := make([]int, 0, len(counts))
+	for _, n := range counts {
+		groups = append(groups, n)
+	}
+	slices.SortFunc(groups, func(a, b int) int { return b - a })
+
+	straight, high := isStraight(counts)
+	switch {
+	case straight && flush && high == model.RankAce:
+		return config.PokerRoyalFlush
+	case straight && flush:
+		return config.PokerStraightFlush
+	case groups[0] == 4:
+		return config.PokerFourOfAKind
+	case groups[0] == 3 && groups[1] == 2:
+		return config.PokerFullHouse
+	case flush:
+		return config.PokerFlush
+	case straight:
+		return config.PokerStraight
+	case groups[0] == 3:
+		return config.PokerThreeOfAKind
+	case groups[0] == 2 && groups[1] == 2:
+		return config.PokerTwoPair
+	case groups[0] == 2:
+		for rank, n := range counts {
+			if n == 2 && rank >= model.RankJack {
+				return config.PokerJacksOrBetter
+			}
+		}
+	}
+	return ""
+}
+
+// isStraight проверяет пять разных рангов подряд; туз может быть младшей картой (A-2-3-4-5).
+// Возвращает старшую карту стрита.
+func isStraight(counts map[int]int) (bool, int) {
+	if len(counts) != model.VideoPokerHandSize {
+		return false, 0
+	}
+	lo, hi := model.RankAce, 0
+	for rank := range counts {
+		lo = min(lo, rank)
+		hi = max(hi, rank)
+	}
+	if hi-lo == model.VideoPokerHandSize-1 {
+		return true, hi
+	}
+	// Младший стрит: туз, 2, 3, 4, 5
+	if counts[model.RankAce] == 1 && counts[2] == 1 && counts[3] == 1 && counts[4] == 1 && counts[5] == 1 {
+		return true, 5
+	}
+	return false, 0
+}
@@ -0,0 +1,58 @@
+package videopoker
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"strings"
+	"testing"
+)
+
+// hand собирает руку из записи вида "Ts Js Qs Ks As": ранг 2-9, T, J, Q, K, A и масть h, d, c, s
+func hand(t *testing.T, notation string) []model.Card {
+	t.Helper()
+	suits := map[byte]string{'h': model.SuitHearts, 'd': model.SuitDiamonds, 'c': model.SuitClubs, 's': model.SuitSpades}
+	var result []model.Card
+	for _, c := range strings.Fields(notation) {
+		if len(c) != 2 {
+			t.Fatalf("bad card %q", c)
+		}
+		rank := strings.IndexByte("23456789TJQKA", c[0]) + 2
+		suit, ok := suits[c[1]]
+		if rank < 2 || !ok {
+			t.Fatalf("bad card %q", c)
+		}
+		result = append(result, model.Card{Rank: rank, Suit: suit})
+	}
+	return result
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name string
+		hand string
+		want string
+	}{
+		{"royal flush", "Ts Js Qs Ks As", config.PokerRoyalFlush},
+		{"straight flush", "9h 5h 6h 7h 8h", config.PokerStraightFlush},
+		{"steel wheel", "Ad 2d 3d 4d 5d", config.PokerStraightFlush},
+		{"four of a kind", "7s 7h 7d 7c 2s", config.PokerFourOfAKind},
+		{"full house", "3s 3h 3d 9c 9s", config.PokerFullHouse},
+		{"flush", "2c 6c 9c Jc Kc", config.PokerFlush},
+		{"straight", "Ts Jh Qd Kc As", config.PokerStraight},
+		{"wheel", "5s 4h 3d 2c As", config.PokerStraight},
+		{"no wrap around the ace", "Qs Kh Ad 2c 3s", ""},
+		{"three of a kind", "Qs Qh Qd 2c 3s", config.PokerThreeOfAKind},
+		{"two pair", "4s 4h 9d 9c 3s", config.PokerTwoPair},
+		{"pair of jacks", "Js Jh 9d 4c 3s", config.PokerJacksOrBetter},
+		{"pair of aces", "As Ah 9d 4c 3s", config.PokerJacksOrBetter},
+		{"pair of tens pays nothing", "Ts Th 9d 4c 3s", ""},
+		{"high card", "2s 5h 9d Jc Ks", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := evaluate(hand(t, tt.hand)); got != tt.want {
+				t.Errorf("evaluate(%v) = %q, want %q", tt.hand, got, tt.want)
+			}
+		})
+	}
+}
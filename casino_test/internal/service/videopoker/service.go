@@ -0,0 +1,29 @@
+package videopoker
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+	"casino_test/pkg/rng"
+	"sync"
+)
+
+type serv struct {
+	cfg    config.VideoPokerConfig
+	repo   repository.VideoPokerRepository
+	wallet repository.WalletRepository
+	rng    rng.RNG
+
+	// Раздача и замена не должны пересекаться — иначе одну руку можно разыграть дважды
+	mtx sync.Mutex
+}
+
+// NewVideoPokerService Создать видеопокер Jacks or Better
+func NewVideoPokerService(cfg config.VideoPokerConfig, repo repository.VideoPokerRepository, wallet repository.WalletRepository, r rng.RNG) service.VideoPokerService {
+	return &serv{
+		cfg:    cfg,
+		repo:   repo,
+		wallet: wallet,
+		rng:    r,
+	}
+}
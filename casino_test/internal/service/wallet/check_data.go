@@ -0,0 +1,15 @@
+package wallet
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+func (s *serv) CheckData(ctx context.Context) (*model.WalletData, error) {
+	balance, err := s.wallet.GetBalance()
+	if err != nil {
+		return nil, errors.New("failed to get user balance")
+	}
+	return &model.WalletData{Balance: balance}, nil
+}
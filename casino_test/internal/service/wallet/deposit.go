@@ -0,0 +1,19 @@
+package wallet
+
+import (
+	"casino_test/internal/model"
+	"context"
+	"errors"
+)
+
+// Deposit пополняет общий кошелёк на amount
+func (s *serv) Deposit(ctx context.Context, amount int) (*model.WalletData, error) {
+	if amount <= 0 {
+		return nil, errors.New("deposit amount must be positive")
+	}
+	balance, err := s.wallet.Credit(amount)
+	if err != nil {
+		return nil, errors.New("failed to update user balance")
+	}
+	return &model.WalletData{Balance: balance}, nil
+}
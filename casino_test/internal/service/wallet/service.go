@@ -0,0 +1,15 @@
+package wallet
+
+import (
+	"casino_test/internal/repository"
+	"casino_test/internal/service"
+)
+
+type serv struct {
+	wallet repository.WalletRepository
+}
+
+// NewWalletService Пополнение и баланс общего кошелька — одни на все игры
+func NewWalletService(wallet repository.WalletRepository) service.WalletService {
+	return &serv{wallet: wallet}
+}
@@ -0,0 +1,104 @@
+// Package wheel общий движок колеса фортуны. Сами игры хранят состояние колеса
+// в своих репозиториях и решают, как зачислить выигрыш и фриспины.
+package wheel
+
+import (
+	"casino_test/internal/config"
+	"casino_test/internal/model"
+	"casino_test/pkg/rng"
+	"errors"
+)
+
+// Start возвращает состояние нового бонуса на стартовом колесе
+func Start(cfg config.WheelConfig, bet int) model.WheelState {
+	return model.WheelState{
+		Active:     true,
+		Bet:        bet,
+		WheelID:    cfg.StartWheel(),
+		Multiplier: 1,
+	}
+}
+
+// View собирает описание текущего колеса для клиента
+func View(cfg config.WheelConfig, state model.WheelState) (model.WheelView, error) {
+	wheel, ok := cfg.Wheel(state.WheelID)
+	if !ok {
+		return model.WheelView{}, errors.New("unknown wheel")
+	}
+
+	segments := make([]model.WheelSegment, len(wheel.Segments))
+	for i, seg := range wheel.Segments {
+		segments[i] = model.WheelSegment{Type: seg.Type, Value: seg.Value}
+	}
+	return model.WheelView{State: state, Segments: segments}, nil
+}
+
+// Spin крутит текущее колесо и продвигает состояние.
+// credit и free_spins заканчивают бонус, multiplier копит множитель, level_up переводит на следующее колесо.
+func Spin(cfg config.WheelConfig, r rng.RNG, state *model.WheelState) (model.WheelSpin, error) {
+	if !state.Active {
+		return model.WheelSpin{}, errors.New("no wheel bonus in progress")
+	}
+	wheel, ok := cfg.Wheel(state.WheelID)
+	if !ok {
+		return model.WheelSpin{}, errors.New("unknown wheel")
+	}
+
+	index := randomSegment(r, wheel.Segments)
+	seg := wheel.Segments[index]
+	res := model.WheelSpin{
+		WheelID:      wheel.ID,
+		SegmentIndex: index,
+		Segment:      model.WheelSegment{Type: seg.Type, Value: seg.Value},
+		Angle:        stopAngle(r, index, len(wheel.Segments)),
+	}
+
+	switch seg.Type {
+	case config.WheelSegmentCredit:
+		res.Payout = seg.Value * state.Multiplier * state.Bet
+		res.Finished = true
+	case config.WheelSegmentFreeSpins:
+		res.FreeSpins = seg.Value
+		res.Finished = true
+	case config.WheelSegmentMultiplier:
+		state.Multiplier *= seg.Value
+	case config.WheelSegmentLevelUp:
+		state.WheelID = wheel.Next
+		state.Level++
+	}
+
+	if res.Finished {
+		state.Active = false
+		return res, nil
+	}
+
+	next, err := View(cfg, *state)
+	if err != nil {
+		return model.WheelSpin{}, err
+	}
+	res.Next = &next
+	return res, nil
+}
+
+// randomSegment выбирает сектор по весам
+func randomSegment(r rng.RNG, segments []config.WheelSegment) int {
+	total := 0
+	for _, seg := range segments {
+		total += seg.Weight
+	}
+
+	n := r.Intn(total)
+	for i, seg := range segments {
+		if n < seg.Weight {
+			return i
+		}
+		n -= seg.Weight
+	}
+	return len(segments) - 1
+}
+
+// stopAngle возвращает угол остановки внутри сектора (сектора равные, не у самой границы)
+func stopAngle(r rng.RNG, index, count int) float64 {
+	width := 360 / float64(count)
+	return width*float64(index) + width*(0.1+0.8*r.Float64())
+}
@@ -0,0 +1,55 @@
+// Package rng источник случайности для игр. Сервисы получают его через конструктор,
+// чтобы генератор можно было подменить (сертифицированный ГСЧ, фиксированный seed).
+package rng
+
+import "math/rand"
+
+type RNG interface {
+	// Intn возвращает число из [0, n)
+	Intn(n int) int
+	// Float64 возвращает число из [0, 1)
+	Float64() float64
+	// Shuffle перемешивает n элементов
+	Shuffle(n int, swap func(i, j int))
+}
+
+type mathRand struct{}
+
+// New ГСЧ на общем источнике math/rand (безопасен для конкурентного использования)
+func New() RNG {
+	return mathRand{}
+}
+
+func (mathRand) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (mathRand) Float64() float64 {
+	return rand.Float64()
+}
+
+func (mathRand) Shuffle(n int, swap func(i, j int)) {
+	rand.Shuffle(n, swap)
+}
+
+type seeded struct {
+	r *rand.Rand
+}
+
+// NewSeeded ГСЧ с фиксированным seed: одна и та же последовательность на каждом запуске.
+// Для тестов и воспроизведения раундов; в отличие от New не безопасен для конкурентного использования
+func NewSeeded(seed int64) RNG {
+	return seeded{r: rand.New(rand.NewSource(seed))}
+}
+
+func (s seeded) Intn(n int) int {
+	return s.r.Intn(n)
+}
+
+func (s seeded) Float64() float64 {
+	return s.r.Float64()
+}
+
+func (s seeded) Shuffle(n int, swap func(i, j int)) {
+	s.r.Shuffle(n, swap)
+}
@@ -0,0 +1,26 @@
+package rng
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewSeededRepeatsSequence(t *testing.T) {
+	draw := func(r RNG) []int {
+		seq := make([]int, 0, 20)
+		for i := 0; i < 10; i++ {
+			seq = append(seq, r.Intn(100))
+		}
+		perm := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		r.Shuffle(len(perm), func(i, j int) { perm[i], perm[j] = perm[j], perm[i] })
+		return append(seq, perm...)
+	}
+
+	a, b := draw(NewSeeded(42)), draw(NewSeeded(42))
+	if !slices.Equal(a, b) {
+		t.Fatalf("same seed gave different sequences:\n%v\n%v", a, b)
+	}
+	if c := draw(NewSeeded(43)); slices.Equal(a, c) {
+		t.Fatalf("different seeds gave the same sequence %v", a)
+	}
+}
@@ -306,7 +306,7 @@ export const useCascadeGameStore = create<CascadeGameStore>((set, get) => ({
 
     if (state.useOnlineMode) {
       try {
-        await CascadeAPI.buyBonus(bonusCost);
+        await CascadeAPI.buyBonus(state.bet);
         const data = await CascadeAPI.checkData();
         set({
           balance: data.balance,
@@ -43,7 +43,8 @@ export interface NewSymbol {
 }
 
 export interface BuyCascadeBonusRequest {
-  amount: number; // Сумма покупки (обычно = bet × 100)
+  product_id: string; // ID продукта из /cascade/game-info
+  bet: number; // Ставка, от которой считается цена (bet × price_x_bet)
 }
 
 export interface CascadeDataResponse {
@@ -72,9 +73,9 @@ export class CascadeAPI {
   /**
    * Купить бонус (фриспины)
    */
-  static async buyBonus(amount: number): Promise<void> {
+  static async buyBonus(bet: number, productId: string = 'standard'): Promise<void> {
     try {
-      const data: BuyCascadeBonusRequest = { amount };
+      const data: BuyCascadeBonusRequest = { product_id: productId, bet };
       await apiClient.getClient().post('/cascade/buy-bonus', data);
     } catch (error) {
       throw this.handleError(error);
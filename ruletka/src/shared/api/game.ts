@@ -1,5 +1,5 @@
 import { apiClient } from './client';
-import { SpinRequest, SpinResult, ErrorResponse, BuyBonusRequest, SimpleResponse } from './types';
+import { SpinRequest, SpinResult, ErrorResponse, BuyBonusRequest, SimpleResponse, CollectResponse } from './types';
 import { AxiosError } from 'axios';
 import { Symbol, SymbolType, WinningLine } from '@shared/types/game';
 import { PAYLINES } from '@shared/config/lines';
@@ -27,7 +27,12 @@ export class GameAPI {
       // Конвертируем результат API в формат приложения
       const reels = this.convertBoardToReels(response.data.board);
       const winAmount = response.data.total_payout;
-      const balance = response.data.balance;
+      let balance = response.data.balance;
+
+      // Игры на удвоение в интерфейсе пока нет — сразу забираем отложенный выигрыш
+      if (response.data.gamble) {
+        balance = (await this.collect()).balance;
+      }
       const winningLines = this.convertWinningLinesFromAPI(response.data.line_wins);
       
       // Отладочное логирование
@@ -77,6 +82,18 @@ export class GameAPI {
     }
   }
 
+  /**
+   * Забрать выигрыш, ожидающий игры на удвоение
+   */
+  static async collect(): Promise<CollectResponse> {
+    try {
+      const response = await apiClient.getClient().post<CollectResponse>('/collect');
+      return response.data;
+    } catch (error) {
+      throw this.handleError(error);
+    }
+  }
+
   /**
    * Купить бонус (фриспины)
    */
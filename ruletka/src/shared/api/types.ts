@@ -22,6 +22,18 @@ export interface SpinResult {
   balance: number; // Баланс после спина
   free_spin_count: number; // Остаток фриспинов
   in_free_spin: boolean; // Это фриспин?
+  gamble: GambleAPI | null; // Выигрыш ждёт удвоения или сбора (null — уже зачислен)
+}
+
+export interface GambleAPI {
+  win: number; // Несобранный выигрыш
+  step: number; // Сколько раз уже рискнули
+  can_gamble: boolean; // Можно ли рискнуть ещё раз
+}
+
+export interface CollectResponse {
+  amount: number; // Зачисленный выигрыш
+  balance: number; // Баланс после
 }
 
 export interface LineWinAPI {